@@ -0,0 +1,36 @@
+{{- define "garden-prometheus.config" -}}
+global:
+  evaluation_interval: 1m
+  scrape_interval: 1m
+  external_labels:
+    cluster: garden
+
+scrape_configs:
+- job_name: garden-prometheus
+  static_configs:
+  - targets: ['localhost:9090']
+{{- $match := .Values.federation.match }}
+{{- range .Values.federation.targets }}
+
+- job_name: federate/{{ .project }}/{{ .shoot }}
+  # Keep the labels of the federated time series, the project, shoot and seed labels are added to identify the Shoot.
+  honor_labels: true
+  metrics_path: /federate
+  params:
+    'match[]':
+{{- range $match }}
+    - {{ . | quote }}
+{{- end }}
+  scheme: https
+  tls_config:
+    ca_file: /etc/prometheus/config/{{ .name }}-ca.crt
+    cert_file: /etc/prometheus/config/{{ .name }}-prometheus.crt
+    key_file: /etc/prometheus/config/{{ .name }}-prometheus.key
+  static_configs:
+  - targets: [{{ .host | quote }}]
+    labels:
+      project: {{ .project | quote }}
+      shoot: {{ .shoot | quote }}
+      seed: {{ .seed | quote }}
+{{- end }}
+{{- end -}}
@@ -0,0 +1 @@
+../../etcd/templates/_helpers.tpl
\ No newline at end of file
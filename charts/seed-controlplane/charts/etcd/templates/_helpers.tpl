@@ -0,0 +1,54 @@
+{{- define "etcd.backup.container" -}}
+{{- if eq .Values.backup.storageType "S3" -}}
+{{ .Values.backup.s3.s3Bucket }}
+{{- else if eq .Values.backup.storageType "ABS" -}}
+{{ .Values.backup.abs.absContainer }}
+{{- else if eq .Values.backup.storageType "GCS" -}}
+{{ .Values.backup.gcs.gcsBucket }}
+{{- else if eq .Values.backup.storageType "Swift" -}}
+{{ .Values.backup.swift.swiftContainer }}
+{{- end -}}
+{{- end -}}
+
+{{- define "etcd.backup.secret" -}}
+{{- if eq .Values.backup.storageType "S3" -}}
+{{ .Values.backup.s3.awsSecret }}
+{{- else if eq .Values.backup.storageType "ABS" -}}
+{{ .Values.backup.abs.absSecret }}
+{{- else if eq .Values.backup.storageType "GCS" -}}
+{{ .Values.backup.gcs.gcsSecret }}
+{{- else if eq .Values.backup.storageType "Swift" -}}
+{{ .Values.backup.swift.swiftSecret }}
+{{- end -}}
+{{- end -}}
+
+{{- define "etcd.backup.env" -}}
+{{- if eq .Values.backup.storageType "S3" -}}
+- name: AWS_SHARED_CREDENTIALS_FILE
+  value: /var/etcd/backup/credentials
+- name: AWS_CONFIG_FILE
+  value: /var/etcd/backup/config
+{{- else if eq .Values.backup.storageType "ABS" -}}
+- name: STORAGE_ACCOUNT
+  valueFrom:
+    secretKeyRef:
+      name: {{ include "etcd.backup.secret" . }}
+      key: storage-account
+- name: STORAGE_KEY
+  valueFrom:
+    secretKeyRef:
+      name: {{ include "etcd.backup.secret" . }}
+      key: storage-key
+{{- else if eq .Values.backup.storageType "GCS" -}}
+- name: GOOGLE_APPLICATION_CREDENTIALS
+  value: /var/etcd/backup/serviceaccount.json
+{{- else if eq .Values.backup.storageType "Swift" -}}
+{{- range $env, $key := dict "OS_AUTH_URL" "authURL" "OS_DOMAIN_NAME" "domainName" "OS_TENANT_NAME" "tenantName" "OS_USERNAME" "username" "OS_PASSWORD" "password" "OS_REGION_NAME" "region" }}
+- name: {{ $env }}
+  valueFrom:
+    secretKeyRef:
+      name: {{ include "etcd.backup.secret" $ }}
+      key: {{ $key }}
+{{- end }}
+{{- end -}}
+{{- end -}}
@@ -4,6 +4,17 @@
 {{- end }}
 {{- end -}}
 
+{{- define "kube-apiserver.admissionPlugins" }}
+{{- if semverCompare "< 1.10" .Values.kubernetesVersion }}
+- --admission-control={{ range $i, $plugin := .Values.admissionPlugins }}{{ if $i }},{{ end }}{{ $plugin.name }}{{ end }}
+{{- else }}
+- --enable-admission-plugins={{ range $i, $plugin := .Values.admissionPlugins }}{{ if $i }},{{ end }}{{ $plugin.name }}{{ end }}
+{{- if .Values.disabledAdmissionPlugins }}
+- --disable-admission-plugins={{ join "," .Values.disabledAdmissionPlugins }}
+{{- end }}
+{{- end }}
+{{- end -}}
+
 {{- define "kube-apiserver.runtimeConfig" }}
 {{- if .Values.runtimeConfig }}
 - --runtime-config={{ range $config, $enabled := .Values.runtimeConfig }}{{ $config }}={{ $enabled }},{{ end }}
@@ -37,3 +48,19 @@
 {{- end }}
 {{- end }}
 {{- end -}}
+
+{{- define "kube-apiserver.auditConfig" }}
+- --audit-policy-file=/etc/kubernetes/audit/audit-policy.yaml
+{{- if .Values.auditConfig.log }}
+- --audit-log-path=-
+- --audit-log-format={{ .Values.auditConfig.log.format }}
+{{- else if not .Values.auditConfig.webhook }}
+- --audit-log-path=/var/lib/audit.log
+- --audit-log-maxsize=100
+- --audit-log-maxbackup=5
+{{- end }}
+{{- if .Values.auditConfig.webhook }}
+- --audit-webhook-config-file=/etc/kubernetes/audit-webhook/kubeconfig
+- --audit-webhook-mode={{ .Values.auditConfig.webhook.mode }}
+{{- end }}
+{{- end -}}
@@ -27,6 +27,17 @@ route:
   receiver: dev-null
 
   routes:
+  # receivers declared by the Shoot owner, alerts continue to the routes below
+{{- range .Values.receivers }}
+  - match_re:
+      severity: {{ .severities }}
+    receiver: {{ .name }}
+    continue: true
+{{- end }}
+  # alerts of the rules supplied by the Shoot owner are only sent to the receivers above
+  - match:
+      origin: shoot
+    receiver: dev-null
   # email only for critical and blocker
   - match_re:
       severity: ^(critical|blocker)$
@@ -59,4 +70,13 @@ receivers:
   email_configs:
 {{ toYaml .Values.email_configs | indent 6 }}
 {{- end }}
+{{- range .Values.receivers }}
+- name: {{ .name }}
+{{- range $key, $configs := . }}
+{{- if and (ne $key "name") (ne $key "severities") }}
+  {{ $key }}:
+{{ toYaml $configs | indent 4 }}
+{{- end }}
+{{- end }}
+{{- end }}
 {{- end -}}
\ No newline at end of file
@@ -0,0 +1,50 @@
+{{- define "gcp-backup.main" -}}
+provider "google" {
+  credentials = "${var.SERVICEACCOUNT}"
+  project     = "{{ required "google.project is required" .Values.google.project }}"
+  region      = "{{ required "google.region is required" .Values.google.region }}"
+}
+
+//=====================================================================
+//= GCS bucket, service account, bucket iam member
+//=====================================================================
+
+resource "google_storage_bucket" "bucket" {
+  name          = "{{ required "bucket.name is required" .Values.bucket.name }}"
+  location      = "{{ required "google.region is required" .Values.google.region }}"
+  storage_class = "REGIONAL"
+  force_destroy = true
+
+  labels {
+    name = "{{ required "bucket.name is required" .Values.bucket.name }}"
+  }
+}
+
+resource "google_service_account" "etcdBackup" {
+  account_id   = "{{ required "serviceAccount.name is required" .Values.serviceAccount.name }}"
+  display_name = "{{ required "clusterName is required" .Values.clusterName }}-etcd-backup"
+}
+
+resource "google_service_account_key" "etcdBackupKey" {
+  service_account_id = "${google_service_account.etcdBackup.name}"
+}
+
+resource "google_storage_bucket_iam_member" "etcdBackup" {
+  bucket = "${google_storage_bucket.bucket.name}"
+  role   = "roles/storage.objectAdmin"
+  member = "serviceAccount:${google_service_account.etcdBackup.email}"
+}
+
+//=====================================================================
+//= Output variables
+//=====================================================================
+
+output "serviceAccountKey" {
+  sensitive = true
+  value     = "${google_service_account_key.etcdBackupKey.private_key}"
+}
+
+output "bucketName" {
+  value = "${google_storage_bucket.bucket.name}"
+}
+{{- end -}}
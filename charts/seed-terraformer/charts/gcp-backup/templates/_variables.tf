@@ -0,0 +1,6 @@
+{{- define "gcp-backup.variables" -}}
+variable "SERVICEACCOUNT" {
+  description = "ServiceAccount"
+  type        = "string"
+}
+{{- end -}}
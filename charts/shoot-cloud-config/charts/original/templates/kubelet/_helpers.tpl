@@ -3,3 +3,16 @@
 --feature-gates={{ range $feature, $enabled := .kubernetes.kubelet.featureGates }}{{ $feature }}={{ $enabled }},{{ end }}
 {{- end }}
 {{- end -}}
+
+{{- define "kubelet.nodeLabels" -}}
+kubernetes.io/role=node,node-role.kubernetes.io/node=,worker.garden.sapcloud.io/group={{ required "workers.name is required" .worker.name }}
+{{- range $key, $value := .worker.labels }},{{ $key }}={{ $value }}{{ end }}
+{{- end -}}
+
+{{- define "kubelet.taints" -}}
+{{- range $index, $taint := .worker.taints }}{{ if $index }},{{ end }}{{ $taint.key }}={{ $taint.value }}:{{ $taint.effect }}{{ end }}
+{{- end -}}
+
+{{- define "kubelet.version" -}}
+{{- default (required "kubernetes.version is required" .kubernetes.version) .worker.kubeletVersion -}}
+{{- end -}}
@@ -73,10 +73,16 @@ type ControllerManagerControllerConfiguration struct {
 	// Seed defines the configuration of the Seed controller.
 	// +optional
 	Seed *SeedControllerConfiguration
+	// GardenMonitoring defines the configuration of the garden monitoring which federates selected metrics
+	// of all Shoot clusters. The garden monitoring is not deployed if it is not set.
+	// +optional
+	GardenMonitoring *GardenMonitoringControllerConfiguration
 	// Shoot defines the configuration of the Shoot controller.
 	Shoot ShootControllerConfiguration
 	// ShootCare defines the configuration of the ShootCare controller.
 	ShootCare ShootCareControllerConfiguration
+	// ShootHibernation defines the configuration of the ShootHibernation controller.
+	ShootHibernation ShootHibernationControllerConfiguration
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -113,12 +119,27 @@ type SeedControllerConfiguration struct {
 	ConcurrentSyncs int
 }
 
+// GardenMonitoringControllerConfiguration defines the configuration of the garden
+// monitoring.
+type GardenMonitoringControllerConfiguration struct {
+	// SyncPeriod is the duration how often the garden monitoring is reconciled (how
+	// often the federation targets are updated with the existing Shoots).
+	SyncPeriod metav1.Duration
+}
+
 // ShootControllerConfiguration defines the configuration of the CloudProfile
 // controller.
 type ShootControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// Certificates contains settings for the generation of the keys and certificates of Shoot clusters.
+	// +optional
+	Certificates *CertificatesConfiguration
+	// CertificateRenewalThreshold is the duration before the expiration of the leaf certificates of a Shoot
+	// cluster at which they are automatically regenerated. If not set, certificates are only rotated on request.
+	// +optional
+	CertificateRenewalThreshold *metav1.Duration
 	// RespectSyncPeriodOverwrite determines whether a sync period overwrite of a
 	// Shoot (via annotation) is respected or not. Defaults to false.
 	// +optional
@@ -137,6 +158,25 @@ type ShootControllerConfiguration struct {
 	WatchNamespace *string
 }
 
+// CertificatesConfiguration contains settings for the generation of the keys and certificates of Shoot
+// clusters. The settings can be overwritten per Shoot by annotations. They only apply to keys and certificates
+// which are newly generated, i.e. existing ones are kept until they are rotated.
+type CertificatesConfiguration struct {
+	// KeyAlgorithm is the algorithm of the private keys of the certificates, either 'RSA' or 'ECDSA' (using the
+	// P-256 curve). Defaults to 'RSA'.
+	// +optional
+	KeyAlgorithm *string
+	// RSAKeySize is the number of bits of RSA private keys. Defaults to 2048.
+	// +optional
+	RSAKeySize *int
+	// CAValidity is the validity period of CA certificates. Defaults to 10 years.
+	// +optional
+	CAValidity *metav1.Duration
+	// LeafValidity is the validity period of server and client certificates. Defaults to 10 years.
+	// +optional
+	LeafValidity *metav1.Duration
+}
+
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
 // controller.
 type ShootCareControllerConfiguration struct {
@@ -149,6 +189,18 @@ type ShootCareControllerConfiguration struct {
 	SyncPeriod metav1.Duration
 }
 
+// ShootHibernationControllerConfiguration defines the configuration of the
+// ShootHibernation controller.
+type ShootHibernationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int
+	// SyncPeriod is the duration how often the existing resources are reconciled (how
+	// often it is checked whether Shoot clusters need to be hibernated or woken up
+	// according to their hibernation schedules).
+	SyncPeriod metav1.Duration
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
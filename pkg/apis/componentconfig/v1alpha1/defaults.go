@@ -93,6 +93,10 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		}
 	}
 
+	if obj.Controllers.GardenMonitoring != nil && obj.Controllers.GardenMonitoring.SyncPeriod.Duration == 0 {
+		obj.Controllers.GardenMonitoring.SyncPeriod = metav1.Duration{Duration: 5 * time.Minute}
+	}
+
 	if obj.Controllers.Shoot.RespectSyncPeriodOverwrite == nil {
 		falseVar := false
 		obj.Controllers.Shoot.RespectSyncPeriodOverwrite = &falseVar
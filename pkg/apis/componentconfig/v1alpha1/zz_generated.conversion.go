@@ -37,6 +37,8 @@ func init() {
 // Public to allow building arbitrary schemes.
 func RegisterConversions(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedConversionFuncs(
+		Convert_v1alpha1_CertificatesConfiguration_To_componentconfig_CertificatesConfiguration,
+		Convert_componentconfig_CertificatesConfiguration_To_v1alpha1_CertificatesConfiguration,
 		Convert_v1alpha1_ClientConnectionConfiguration_To_componentconfig_ClientConnectionConfiguration,
 		Convert_componentconfig_ClientConnectionConfiguration_To_v1alpha1_ClientConnectionConfiguration,
 		Convert_v1alpha1_CloudProfileControllerConfiguration_To_componentconfig_CloudProfileControllerConfiguration,
@@ -45,6 +47,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_componentconfig_ControllerManagerConfiguration_To_v1alpha1_ControllerManagerConfiguration,
 		Convert_v1alpha1_ControllerManagerControllerConfiguration_To_componentconfig_ControllerManagerControllerConfiguration,
 		Convert_componentconfig_ControllerManagerControllerConfiguration_To_v1alpha1_ControllerManagerControllerConfiguration,
+		Convert_v1alpha1_GardenMonitoringControllerConfiguration_To_componentconfig_GardenMonitoringControllerConfiguration,
+		Convert_componentconfig_GardenMonitoringControllerConfiguration_To_v1alpha1_GardenMonitoringControllerConfiguration,
 		Convert_v1alpha1_LeaderElectionConfiguration_To_componentconfig_LeaderElectionConfiguration,
 		Convert_componentconfig_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration,
 		Convert_v1alpha1_MetricsConfiguration_To_componentconfig_MetricsConfiguration,
@@ -61,6 +65,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_componentconfig_ShootCareControllerConfiguration_To_v1alpha1_ShootCareControllerConfiguration,
 		Convert_v1alpha1_ShootControllerConfiguration_To_componentconfig_ShootControllerConfiguration,
 		Convert_componentconfig_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration,
+		Convert_v1alpha1_ShootHibernationControllerConfiguration_To_componentconfig_ShootHibernationControllerConfiguration,
+		Convert_componentconfig_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration,
 		Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_componentconfig_ShootMaintenanceControllerConfiguration,
 		Convert_componentconfig_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration,
 		Convert_v1alpha1_ShootQuotaControllerConfiguration_To_componentconfig_ShootQuotaControllerConfiguration,
@@ -68,6 +74,32 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 	)
 }
 
+func autoConvert_v1alpha1_CertificatesConfiguration_To_componentconfig_CertificatesConfiguration(in *CertificatesConfiguration, out *componentconfig.CertificatesConfiguration, s conversion.Scope) error {
+	out.KeyAlgorithm = (*string)(unsafe.Pointer(in.KeyAlgorithm))
+	out.RSAKeySize = (*int)(unsafe.Pointer(in.RSAKeySize))
+	out.CAValidity = (*v1.Duration)(unsafe.Pointer(in.CAValidity))
+	out.LeafValidity = (*v1.Duration)(unsafe.Pointer(in.LeafValidity))
+	return nil
+}
+
+// Convert_v1alpha1_CertificatesConfiguration_To_componentconfig_CertificatesConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_CertificatesConfiguration_To_componentconfig_CertificatesConfiguration(in *CertificatesConfiguration, out *componentconfig.CertificatesConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CertificatesConfiguration_To_componentconfig_CertificatesConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_CertificatesConfiguration_To_v1alpha1_CertificatesConfiguration(in *componentconfig.CertificatesConfiguration, out *CertificatesConfiguration, s conversion.Scope) error {
+	out.KeyAlgorithm = (*string)(unsafe.Pointer(in.KeyAlgorithm))
+	out.RSAKeySize = (*int)(unsafe.Pointer(in.RSAKeySize))
+	out.CAValidity = (*v1.Duration)(unsafe.Pointer(in.CAValidity))
+	out.LeafValidity = (*v1.Duration)(unsafe.Pointer(in.LeafValidity))
+	return nil
+}
+
+// Convert_componentconfig_CertificatesConfiguration_To_v1alpha1_CertificatesConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_CertificatesConfiguration_To_v1alpha1_CertificatesConfiguration(in *componentconfig.CertificatesConfiguration, out *CertificatesConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_CertificatesConfiguration_To_v1alpha1_CertificatesConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ClientConnectionConfiguration_To_componentconfig_ClientConnectionConfiguration(in *ClientConnectionConfiguration, out *componentconfig.ClientConnectionConfiguration, s conversion.Scope) error {
 	out.KubeConfigFile = in.KubeConfigFile
 	out.AcceptContentTypes = in.AcceptContentTypes
@@ -173,12 +205,16 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_componentc
 	out.SecretBinding = (*componentconfig.SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Quota = (*componentconfig.QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
 	out.Seed = (*componentconfig.SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
+	out.GardenMonitoring = (*componentconfig.GardenMonitoringControllerConfiguration)(unsafe.Pointer(in.GardenMonitoring))
 	if err := Convert_v1alpha1_ShootControllerConfiguration_To_componentconfig_ShootControllerConfiguration(&in.Shoot, &out.Shoot, s); err != nil {
 		return err
 	}
 	if err := Convert_v1alpha1_ShootCareControllerConfiguration_To_componentconfig_ShootCareControllerConfiguration(&in.ShootCare, &out.ShootCare, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_ShootHibernationControllerConfiguration_To_componentconfig_ShootHibernationControllerConfiguration(&in.ShootHibernation, &out.ShootHibernation, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_componentconfig_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
@@ -198,12 +234,16 @@ func autoConvert_componentconfig_ControllerManagerControllerConfiguration_To_v1a
 	out.SecretBinding = (*SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Quota = (*QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
 	out.Seed = (*SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
+	out.GardenMonitoring = (*GardenMonitoringControllerConfiguration)(unsafe.Pointer(in.GardenMonitoring))
 	if err := Convert_componentconfig_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration(&in.Shoot, &out.Shoot, s); err != nil {
 		return err
 	}
 	if err := Convert_componentconfig_ShootCareControllerConfiguration_To_v1alpha1_ShootCareControllerConfiguration(&in.ShootCare, &out.ShootCare, s); err != nil {
 		return err
 	}
+	if err := Convert_componentconfig_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration(&in.ShootHibernation, &out.ShootHibernation, s); err != nil {
+		return err
+	}
 	if err := Convert_componentconfig_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
@@ -218,6 +258,26 @@ func Convert_componentconfig_ControllerManagerControllerConfiguration_To_v1alpha
 	return autoConvert_componentconfig_ControllerManagerControllerConfiguration_To_v1alpha1_ControllerManagerControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_GardenMonitoringControllerConfiguration_To_componentconfig_GardenMonitoringControllerConfiguration(in *GardenMonitoringControllerConfiguration, out *componentconfig.GardenMonitoringControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_v1alpha1_GardenMonitoringControllerConfiguration_To_componentconfig_GardenMonitoringControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_GardenMonitoringControllerConfiguration_To_componentconfig_GardenMonitoringControllerConfiguration(in *GardenMonitoringControllerConfiguration, out *componentconfig.GardenMonitoringControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GardenMonitoringControllerConfiguration_To_componentconfig_GardenMonitoringControllerConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_GardenMonitoringControllerConfiguration_To_v1alpha1_GardenMonitoringControllerConfiguration(in *componentconfig.GardenMonitoringControllerConfiguration, out *GardenMonitoringControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_componentconfig_GardenMonitoringControllerConfiguration_To_v1alpha1_GardenMonitoringControllerConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_GardenMonitoringControllerConfiguration_To_v1alpha1_GardenMonitoringControllerConfiguration(in *componentconfig.GardenMonitoringControllerConfiguration, out *GardenMonitoringControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_GardenMonitoringControllerConfiguration_To_v1alpha1_GardenMonitoringControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_LeaderElectionConfiguration_To_componentconfig_LeaderElectionConfiguration(in *LeaderElectionConfiguration, out *componentconfig.LeaderElectionConfiguration, s conversion.Scope) error {
 	out.LeaderElect = in.LeaderElect
 	out.LeaseDuration = in.LeaseDuration
@@ -376,6 +436,8 @@ func Convert_componentconfig_ShootCareControllerConfiguration_To_v1alpha1_ShootC
 
 func autoConvert_v1alpha1_ShootControllerConfiguration_To_componentconfig_ShootControllerConfiguration(in *ShootControllerConfiguration, out *componentconfig.ShootControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.Certificates = (*componentconfig.CertificatesConfiguration)(unsafe.Pointer(in.Certificates))
+	out.CertificateRenewalThreshold = (*v1.Duration)(unsafe.Pointer(in.CertificateRenewalThreshold))
 	out.RespectSyncPeriodOverwrite = (*bool)(unsafe.Pointer(in.RespectSyncPeriodOverwrite))
 	out.RetryDuration = in.RetryDuration
 	out.RetrySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.RetrySyncPeriod))
@@ -391,6 +453,8 @@ func Convert_v1alpha1_ShootControllerConfiguration_To_componentconfig_ShootContr
 
 func autoConvert_componentconfig_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration(in *componentconfig.ShootControllerConfiguration, out *ShootControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.Certificates = (*CertificatesConfiguration)(unsafe.Pointer(in.Certificates))
+	out.CertificateRenewalThreshold = (*v1.Duration)(unsafe.Pointer(in.CertificateRenewalThreshold))
 	out.RespectSyncPeriodOverwrite = (*bool)(unsafe.Pointer(in.RespectSyncPeriodOverwrite))
 	out.RetryDuration = in.RetryDuration
 	out.RetrySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.RetrySyncPeriod))
@@ -404,6 +468,28 @@ func Convert_componentconfig_ShootControllerConfiguration_To_v1alpha1_ShootContr
 	return autoConvert_componentconfig_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootHibernationControllerConfiguration_To_componentconfig_ShootHibernationControllerConfiguration(in *ShootHibernationControllerConfiguration, out *componentconfig.ShootHibernationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_v1alpha1_ShootHibernationControllerConfiguration_To_componentconfig_ShootHibernationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootHibernationControllerConfiguration_To_componentconfig_ShootHibernationControllerConfiguration(in *ShootHibernationControllerConfiguration, out *componentconfig.ShootHibernationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootHibernationControllerConfiguration_To_componentconfig_ShootHibernationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration(in *componentconfig.ShootHibernationControllerConfiguration, out *ShootHibernationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_componentconfig_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration(in *componentconfig.ShootHibernationControllerConfiguration, out *ShootHibernationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootMaintenanceControllerConfiguration_To_componentconfig_ShootMaintenanceControllerConfiguration(in *ShootMaintenanceControllerConfiguration, out *componentconfig.ShootMaintenanceControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
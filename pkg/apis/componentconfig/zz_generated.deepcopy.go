@@ -25,6 +25,58 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesConfiguration) DeepCopyInto(out *CertificatesConfiguration) {
+	*out = *in
+	if in.KeyAlgorithm != nil {
+		in, out := &in.KeyAlgorithm, &out.KeyAlgorithm
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.RSAKeySize != nil {
+		in, out := &in.RSAKeySize, &out.RSAKeySize
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int)
+			**out = **in
+		}
+	}
+	if in.CAValidity != nil {
+		in, out := &in.CAValidity, &out.CAValidity
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.LeafValidity != nil {
+		in, out := &in.LeafValidity, &out.LeafValidity
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesConfiguration.
+func (in *CertificatesConfiguration) DeepCopy() *CertificatesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientConnectionConfiguration) DeepCopyInto(out *ClientConnectionConfiguration) {
 	*out = *in
@@ -135,8 +187,18 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 			**out = **in
 		}
 	}
+	if in.GardenMonitoring != nil {
+		in, out := &in.GardenMonitoring, &out.GardenMonitoring
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(GardenMonitoringControllerConfiguration)
+			**out = **in
+		}
+	}
 	in.Shoot.DeepCopyInto(&out.Shoot)
 	out.ShootCare = in.ShootCare
+	out.ShootHibernation = in.ShootHibernation
 	out.ShootMaintenance = in.ShootMaintenance
 	out.ShootQuota = in.ShootQuota
 	return
@@ -152,6 +214,23 @@ func (in *ControllerManagerControllerConfiguration) DeepCopy() *ControllerManage
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenMonitoringControllerConfiguration) DeepCopyInto(out *GardenMonitoringControllerConfiguration) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenMonitoringControllerConfiguration.
+func (in *GardenMonitoringControllerConfiguration) DeepCopy() *GardenMonitoringControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenMonitoringControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaderElectionConfiguration) DeepCopyInto(out *LeaderElectionConfiguration) {
 	*out = *in
@@ -272,6 +351,24 @@ func (in *ShootCareControllerConfiguration) DeepCopy() *ShootCareControllerConfi
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfiguration) {
 	*out = *in
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(CertificatesConfiguration)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.CertificateRenewalThreshold != nil {
+		in, out := &in.CertificateRenewalThreshold, &out.CertificateRenewalThreshold
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
 	if in.RespectSyncPeriodOverwrite != nil {
 		in, out := &in.RespectSyncPeriodOverwrite, &out.RespectSyncPeriodOverwrite
 		if *in == nil {
@@ -314,6 +411,23 @@ func (in *ShootControllerConfiguration) DeepCopy() *ShootControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootHibernationControllerConfiguration) DeepCopyInto(out *ShootHibernationControllerConfiguration) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootHibernationControllerConfiguration.
+func (in *ShootHibernationControllerConfiguration) DeepCopy() *ShootHibernationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootHibernationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMaintenanceControllerConfiguration) {
 	*out = *in
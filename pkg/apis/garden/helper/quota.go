@@ -0,0 +1,241 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// QuotaMetricNames is the list of resources which can be constrained by Quotas.
+var QuotaMetricNames = []corev1.ResourceName{
+	garden.QuotaMetricCPU,
+	garden.QuotaMetricGPU,
+	garden.QuotaMetricMemory,
+	garden.QuotaMetricCPUPreemptible,
+	garden.QuotaMetricGPUPreemptible,
+	garden.QuotaMetricMemoryPreemptible,
+	garden.QuotaMetricStorageStandard,
+	garden.QuotaMetricStoragePremium,
+	garden.QuotaMetricLoadbalancer,
+}
+
+// PreemptibleQuotaMetrics maps the regular Quota metrics to the metrics which account the resources of
+// preemptible machines separately.
+var PreemptibleQuotaMetrics = map[corev1.ResourceName]corev1.ResourceName{
+	garden.QuotaMetricCPU:    garden.QuotaMetricCPUPreemptible,
+	garden.QuotaMetricGPU:    garden.QuotaMetricGPUPreemptible,
+	garden.QuotaMetricMemory: garden.QuotaMetricMemoryPreemptible,
+}
+
+type quotaWorker struct {
+	garden.Worker
+	// VolumeType is the type of the root volumes.
+	VolumeType string
+	// VolumeSize is the size of the root volume.
+	VolumeSize resource.Quantity
+	// Preemptible indicates whether the machines are preemptible instances.
+	Preemptible bool
+}
+
+// ComputeShootResources computes the amount of the resources constrained by Quotas which is allocated
+// by the given Shoot. The <cloudProfile> must be the CloudProfile referenced by the Shoot.
+func ComputeShootResources(shoot garden.Shoot, cloudProfile garden.CloudProfile) (corev1.ResourceList, error) {
+	cloudProvider, err := DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+	if err != nil {
+		return nil, errors.New("could not identify the cloud provider kind in the Shoot resource")
+	}
+
+	var (
+		countLB      int64 = 1
+		resources          = make(corev1.ResourceList)
+		workers            = getShootWorkerResources(shoot, cloudProvider, cloudProfile)
+		machineTypes       = getMachineTypes(cloudProvider, cloudProfile)
+		volumeTypes        = getVolumeTypes(cloudProvider, cloudProfile)
+	)
+
+	for _, worker := range workers {
+		var (
+			machineType *garden.MachineType
+			volumeType  *garden.VolumeType
+		)
+
+		// Get the proper machineType
+		for _, element := range machineTypes {
+			if element.Name == worker.MachineType {
+				machineType = &element
+				break
+			}
+		}
+		if machineType == nil {
+			return nil, fmt.Errorf("MachineType %s not found in CloudProfile %s", worker.MachineType, cloudProfile.Name)
+		}
+
+		// Get the proper VolumeType
+		for _, element := range volumeTypes {
+			if element.Name == worker.VolumeType {
+				volumeType = &element
+				break
+			}
+		}
+		if volumeType == nil {
+			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile %s", worker.MachineType, cloudProfile.Name)
+		}
+
+		// Preemptible machines are accounted separately from regular machines.
+		metricCPU, metricGPU, metricMemory := garden.QuotaMetricCPU, garden.QuotaMetricGPU, garden.QuotaMetricMemory
+		if worker.Preemptible {
+			metricCPU, metricGPU, metricMemory = garden.QuotaMetricCPUPreemptible, garden.QuotaMetricGPUPreemptible, garden.QuotaMetricMemoryPreemptible
+		}
+
+		// For now we always use the max. amount of resources for quota calculation
+		resources[metricCPU] = SumQuantities(resources[metricCPU], MultiplyQuantity(machineType.CPU, worker.AutoScalerMax))
+		resources[metricGPU] = SumQuantities(resources[metricGPU], MultiplyQuantity(machineType.GPU, worker.AutoScalerMax))
+		resources[metricMemory] = SumQuantities(resources[metricMemory], MultiplyQuantity(machineType.Memory, worker.AutoScalerMax))
+
+		switch volumeType.Class {
+		case garden.VolumeClassStandard:
+			resources[garden.QuotaMetricStorageStandard] = SumQuantities(resources[garden.QuotaMetricStorageStandard], MultiplyQuantity(worker.VolumeSize, worker.AutoScalerMax))
+		case garden.VolumeClassPremium:
+			resources[garden.QuotaMetricStoragePremium] = SumQuantities(resources[garden.QuotaMetricStoragePremium], MultiplyQuantity(worker.VolumeSize, worker.AutoScalerMax))
+		default:
+			return nil, fmt.Errorf("Unknown volumeType class %s", volumeType.Class)
+		}
+	}
+
+	if shoot.Spec.Addons != nil && shoot.Spec.Addons.NginxIngress != nil && shoot.Spec.Addons.NginxIngress.Addon.Enabled {
+		countLB++
+	}
+	resources[garden.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+
+	return resources, nil
+}
+
+func getShootWorkerResources(shoot garden.Shoot, cloudProvider garden.CloudProvider, cloudProfile garden.CloudProfile) []quotaWorker {
+	var workers []quotaWorker
+
+	switch cloudProvider {
+	case garden.CloudProviderAWS:
+		workers = make([]quotaWorker, len(shoot.Spec.Cloud.AWS.Workers))
+
+		for idx, awsWorker := range shoot.Spec.Cloud.AWS.Workers {
+			workers[idx].Worker = awsWorker.Worker
+			workers[idx].VolumeType = awsWorker.VolumeType
+			workers[idx].VolumeSize = resource.MustParse(awsWorker.VolumeSize)
+		}
+	case garden.CloudProviderAzure:
+		workers = make([]quotaWorker, len(shoot.Spec.Cloud.Azure.Workers))
+
+		for idx, azureWorker := range shoot.Spec.Cloud.Azure.Workers {
+			workers[idx].Worker = azureWorker.Worker
+			workers[idx].VolumeType = azureWorker.VolumeType
+			workers[idx].VolumeSize = resource.MustParse(azureWorker.VolumeSize)
+		}
+	case garden.CloudProviderGCP:
+		workers = make([]quotaWorker, len(shoot.Spec.Cloud.GCP.Workers))
+
+		for idx, gcpWorker := range shoot.Spec.Cloud.GCP.Workers {
+			workers[idx].Worker = gcpWorker.Worker
+			workers[idx].VolumeType = gcpWorker.VolumeType
+			workers[idx].VolumeSize = resource.MustParse(gcpWorker.VolumeSize)
+			workers[idx].Preemptible = gcpWorker.Preemptible != nil && *gcpWorker.Preemptible
+		}
+	case garden.CloudProviderOpenStack:
+		workers = make([]quotaWorker, len(shoot.Spec.Cloud.OpenStack.Workers))
+
+		for idx, osWorker := range shoot.Spec.Cloud.OpenStack.Workers {
+			workers[idx].Worker = osWorker.Worker
+			for _, machineType := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
+				if osWorker.MachineType == machineType.Name {
+					workers[idx].VolumeType = machineType.MachineType.Name
+					workers[idx].VolumeSize = machineType.VolumeSize
+				}
+			}
+		}
+	}
+	return workers
+}
+
+func getMachineTypes(provider garden.CloudProvider, cloudProfile garden.CloudProfile) []garden.MachineType {
+	var machineTypes []garden.MachineType
+	switch provider {
+	case garden.CloudProviderAWS:
+		machineTypes = cloudProfile.Spec.AWS.Constraints.MachineTypes
+	case garden.CloudProviderAzure:
+		machineTypes = cloudProfile.Spec.Azure.Constraints.MachineTypes
+	case garden.CloudProviderGCP:
+		machineTypes = cloudProfile.Spec.GCP.Constraints.MachineTypes
+	case garden.CloudProviderOpenStack:
+		machineTypes = make([]garden.MachineType, 0)
+		for _, element := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
+			machineTypes = append(machineTypes, element.MachineType)
+		}
+	}
+	return machineTypes
+}
+
+func getVolumeTypes(provider garden.CloudProvider, cloudProfile garden.CloudProfile) []garden.VolumeType {
+	var volumeTypes []garden.VolumeType
+	switch provider {
+	case garden.CloudProviderAWS:
+		volumeTypes = cloudProfile.Spec.AWS.Constraints.VolumeTypes
+	case garden.CloudProviderAzure:
+		volumeTypes = cloudProfile.Spec.Azure.Constraints.VolumeTypes
+	case garden.CloudProviderGCP:
+		volumeTypes = cloudProfile.Spec.GCP.Constraints.VolumeTypes
+	case garden.CloudProviderOpenStack:
+		volumeTypes = make([]garden.VolumeType, 0)
+		contains := func(types []garden.VolumeType, volumeType string) bool {
+			for _, element := range types {
+				if element.Name == volumeType {
+					return true
+				}
+			}
+			return false
+		}
+
+		for _, machineType := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
+			if !contains(volumeTypes, machineType.MachineType.Name) {
+				volumeTypes = append(volumeTypes, garden.VolumeType{
+					Name:  machineType.MachineType.Name,
+					Class: machineType.VolumeType,
+				})
+			}
+		}
+	}
+	return volumeTypes
+}
+
+// SumQuantities returns the sum of the given quantities.
+func SumQuantities(values ...resource.Quantity) resource.Quantity {
+	res := resource.Quantity{}
+	for _, v := range values {
+		res.Add(v)
+	}
+	return res
+}
+
+// MultiplyQuantity returns the given quantity multiplied with <multiplier>.
+func MultiplyQuantity(quantity resource.Quantity, multiplier int) resource.Quantity {
+	res := resource.Quantity{}
+	for i := 0; i < multiplier; i++ {
+		res.Add(quantity)
+	}
+	return res
+}
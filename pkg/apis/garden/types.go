@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 ////////////////////////////////////////////////////
@@ -182,6 +183,9 @@ type GCPConstraints struct {
 	MachineImages []GCPMachineImage
 	// MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.
 	MachineTypes []MachineType
+	// PreemptibleMachineTypes contains the names of the machine types which may be used for preemptible VMs.
+	// +optional
+	PreemptibleMachineTypes []string
 	// VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.
 	VolumeTypes []VolumeType
 	// Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.
@@ -417,6 +421,9 @@ type Quota struct {
 	// Spec defines the Quota constraints.
 	// +optional
 	Spec QuotaSpec
+	// Status contains the most recently observed usage of the Quota.
+	// +optional
+	Status QuotaStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -442,6 +449,27 @@ type QuotaSpec struct {
 	Scope QuotaScope
 }
 
+// QuotaStatus holds the most recently observed usage of a Quota.
+type QuotaStatus struct {
+	// Used is the amount of the constrained resources which is allocated by all Shoots consuming the Quota.
+	// +optional
+	Used corev1.ResourceList
+	// Shoots is the list of Shoots which consume the Quota together with the resources each of them allocates.
+	// +optional
+	Shoots []QuotaShootUsage
+}
+
+// QuotaShootUsage is the amount of the constrained resources which is allocated by a single Shoot.
+type QuotaShootUsage struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string
+	// Name is the name of the Shoot.
+	Name string
+	// Used is the amount of the constrained resources which is allocated by the Shoot.
+	// +optional
+	Used corev1.ResourceList
+}
+
 const (
 	// QuotaMetricCPU is the constraint for the amount of CPUs
 	QuotaMetricCPU corev1.ResourceName = corev1.ResourceCPU
@@ -449,6 +477,12 @@ const (
 	QuotaMetricGPU corev1.ResourceName = "gpu"
 	// QuotaMetricMemory is the constraint for the amount of memory
 	QuotaMetricMemory corev1.ResourceName = corev1.ResourceMemory
+	// QuotaMetricCPUPreemptible is the constraint for the amount of CPUs of preemptible machines
+	QuotaMetricCPUPreemptible corev1.ResourceName = corev1.ResourceCPU + ".preemptible"
+	// QuotaMetricGPUPreemptible is the constraint for the amount of GPUs of preemptible machines
+	QuotaMetricGPUPreemptible corev1.ResourceName = "gpu.preemptible"
+	// QuotaMetricMemoryPreemptible is the constraint for the amount of memory of preemptible machines
+	QuotaMetricMemoryPreemptible corev1.ResourceName = corev1.ResourceMemory + ".preemptible"
 	// QuotaMetricStorageStandard is the constraint for the size of a standard disk
 	QuotaMetricStorageStandard corev1.ResourceName = corev1.ResourceStorage + ".standard"
 	// QuotaMetricStoragePremium is the constraint for the size of a premium disk (e.g. SSD)
@@ -542,12 +576,19 @@ type ShootSpec struct {
 	Cloud Cloud
 	// DNS contains information about the DNS settings of the Shoot.
 	DNS DNS
+	// Hibernation contains information whether the Shoot is suspended or not and the schedules which
+	// determine when it is automatically hibernated or woken up.
+	// +optional
+	Hibernation *Hibernation
 	// Kubernetes contains the version and configuration settings of the control plane components.
 	Kubernetes Kubernetes
 	// Maintenance contains information about the time window for maintenance operations and which
 	// operations should be performed.
 	// +optional
 	Maintenance *Maintenance
+	// Monitoring contains information about the monitoring configuration of the Shoot.
+	// +optional
+	Monitoring *Monitoring
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -574,6 +615,13 @@ type ShootStatus struct {
 	// UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters.
 	// It is used to compute unique hashes.
 	UID types.UID
+	// Seed is the name of the Seed cluster which currently hosts the control plane of the Shoot cluster. If it differs
+	// from the Seed cluster referenced in the specification then the control plane is migrated.
+	// +optional
+	Seed *string
+	// Certificates contains the expiration dates of the certificates used by the control plane of the Shoot cluster.
+	// +optional
+	Certificates []Certificate
 }
 
 ///////////////////////////////
@@ -607,6 +655,10 @@ type Cloud struct {
 	// Vagrant contains the Shoot specification for the Vagrant local provider.
 	// +optional
 	Vagrant *VagrantLocal
+	// SequentialWorkerRollout indicates whether changes to the worker groups are rolled out one worker group after
+	// the other (in the order of their declaration) instead of all at once.
+	// +optional
+	SequentialWorkerRollout *bool
 }
 
 // K8SNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.
@@ -668,6 +720,10 @@ type AWSWorker struct {
 	VolumeType string
 	// VolumeSize is the size of the root volume.
 	VolumeSize string
+	// MachineImage holds information about the machine image to use for this worker group.
+	// It overrides the cluster-wide machine image if set.
+	// +optional
+	MachineImage *AWSMachineImage
 }
 
 // AzureCloud contains the Shoot specification for Azure.
@@ -718,6 +774,10 @@ type AzureWorker struct {
 	VolumeType string
 	// VolumeSize is the size of the root volume.
 	VolumeSize string
+	// MachineImage holds information about the machine image to use for this worker group.
+	// It overrides the cluster-wide machine image if set.
+	// +optional
+	MachineImage *AzureMachineImage
 }
 
 // GCPCloud contains the Shoot specification for GCP.
@@ -758,6 +818,13 @@ type GCPWorker struct {
 	VolumeType string
 	// VolumeSize is the size of the root volume.
 	VolumeSize string
+	// MachineImage holds information about the machine image to use for this worker group.
+	// It overrides the cluster-wide machine image if set.
+	// +optional
+	MachineImage *GCPMachineImage
+	// Preemptible indicates whether the machines of this worker group shall be preemptible VMs.
+	// +optional
+	Preemptible *bool
 }
 
 // OpenStackCloud contains the Shoot specification for OpenStack.
@@ -798,6 +865,10 @@ type OpenStackRouter struct {
 // OpenStackWorker is the definition of a worker group.
 type OpenStackWorker struct {
 	Worker
+	// MachineImage holds information about the machine image to use for this worker group.
+	// It overrides the cluster-wide machine image if set.
+	// +optional
+	MachineImage *OpenStackMachineImage
 }
 
 // VagrantLocal contains the Shoot specification for local Vagrant provider.
@@ -823,6 +894,28 @@ type Worker struct {
 	AutoScalerMin int
 	// AutoScalerMin is the maximum number of VMs to create.
 	AutoScalerMax int
+	// Labels is a map of key/value pairs which are added to the nodes of the worker group.
+	// +optional
+	Labels map[string]string
+	// Annotations is a map of key/value pairs which are added to the nodes of the worker group.
+	// +optional
+	Annotations map[string]string
+	// Taints is a list of taints which are added to the nodes of the worker group.
+	// +optional
+	Taints []corev1.Taint
+	// KubeletVersion is the version of the kubelet running on the nodes of the worker group. It must not be
+	// newer than the version of the control plane and at most two minor versions older. It defaults to the
+	// Kubernetes version of the Shoot cluster if not set.
+	// +optional
+	KubeletVersion *string
+	// MaxSurge is the maximum number of VMs that are created during a rolling update of the worker group (per zone).
+	// It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.
+	// +optional
+	MaxSurge *intstr.IntOrString
+	// MaxUnavailable is the maximum number of VMs that can be unavailable during a rolling update of the worker group
+	// (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString
 }
 
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
@@ -1007,8 +1100,82 @@ type KubeAPIServerConfig struct {
 	// OIDCConfig contains configuration settings for the OIDC provider.
 	// +optional
 	OIDCConfig *OIDCConfig
+	// AuditConfig contains configuration settings for the audit of the kube-apiserver.
+	// +optional
+	AuditConfig *AuditConfig
+	// AdmissionPlugins contains the list of user-defined admission plugins (additional to those managed by Gardener)
+	// and, if desired, the corresponding configuration.
+	// +optional
+	AdmissionPlugins []AdmissionPlugin
 }
 
+// AdmissionPlugin contains information about a specific admission plugin and its corresponding configuration.
+type AdmissionPlugin struct {
+	// Name is the name of the plugin.
+	Name string
+	// Enabled indicates whether the plugin is enabled or disabled (default true).
+	// +optional
+	Enabled *bool
+	// Config is the configuration of the plugin (a YAML or JSON document).
+	// +optional
+	Config *string
+}
+
+// AuditConfig contains settings for the audit of the kube-apiserver.
+type AuditConfig struct {
+	// AuditPolicy contains configuration settings for the audit policy of the kube-apiserver.
+	// +optional
+	AuditPolicy *AuditPolicy
+	// Log contains configuration settings for the log backend of the audit. If set, audit events are written
+	// to the standard output of the kube-apiserver container.
+	// +optional
+	Log *AuditLogBackend
+	// Webhook contains configuration settings for the webhook backend of the audit. If set, audit events are
+	// sent to the configured remote API.
+	// +optional
+	Webhook *AuditWebhookBackend
+}
+
+// AuditPolicy contains configuration settings for the audit policy of the kube-apiserver.
+type AuditPolicy struct {
+	// ConfigMapRef is a reference to a ConfigMap object in the same namespace as the Shoot which contains the
+	// audit policy for the kube-apiserver in its `policy` key.
+	// +optional
+	ConfigMapRef *corev1.LocalObjectReference
+}
+
+// AuditLogBackend contains configuration settings for the log backend of the audit.
+type AuditLogBackend struct {
+	// Format is the format of the written audit events. Known formats are "legacy" and "json" (default "json").
+	// +optional
+	Format *string
+}
+
+// AuditWebhookBackend contains configuration settings for the webhook backend of the audit.
+type AuditWebhookBackend struct {
+	// KubeconfigSecretRef is a reference to a Secret object in the same namespace as the Shoot which contains a
+	// kubeconfig for the remote API in its `kubeconfig` key.
+	KubeconfigSecretRef corev1.LocalObjectReference
+	// Mode is the strategy for sending audit events. Known modes are "batch" and "blocking" (default "batch").
+	// +optional
+	Mode *string
+}
+
+const (
+	// AuditPolicyConfigMapDataKey is the key in a ConfigMap which contains the audit policy.
+	AuditPolicyConfigMapDataKey = "policy"
+	// AuditWebhookKubeconfigSecretDataKey is the key in a Secret which contains the kubeconfig for the audit webhook.
+	AuditWebhookKubeconfigSecretDataKey = "kubeconfig"
+	// AuditLogFormatJSON is a constant for the 'json' audit log format.
+	AuditLogFormatJSON = "json"
+	// AuditLogFormatLegacy is a constant for the 'legacy' audit log format.
+	AuditLogFormatLegacy = "legacy"
+	// AuditWebhookModeBatch is a constant for the 'batch' audit webhook mode.
+	AuditWebhookModeBatch = "batch"
+	// AuditWebhookModeBlocking is a constant for the 'blocking' audit webhook mode.
+	AuditWebhookModeBlocking = "blocking"
+)
+
 // OIDCConfig contains configuration settings for the OIDC provider.
 // Note: Descriptions were taken from the Kubernetes documentation.
 type OIDCConfig struct {
@@ -1038,6 +1205,27 @@ type OIDCConfig struct {
 // KubeControllerManagerConfig contains configuration settings for the kube-controller-manager.
 type KubeControllerManagerConfig struct {
 	KubernetesConfig
+	// HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
+	// +optional
+	HorizontalPodAutoscalerConfig *HorizontalPodAutoscalerConfig
+	// NodeMonitorGracePeriod is the amount of time which a running node is allowed to be unresponsive before it is
+	// marked unhealthy (default 40s).
+	// +optional
+	NodeMonitorGracePeriod *metav1.Duration
+}
+
+// HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
+// Note: Descriptions were taken from the Kubernetes documentation.
+type HorizontalPodAutoscalerConfig struct {
+	// The period for syncing the number of pods in horizontal pod autoscaler (default 30s).
+	// +optional
+	SyncPeriod *metav1.Duration
+	// The period since last downscale, before another downscale can be performed in horizontal pod autoscaler (default 5m0s).
+	// +optional
+	DownscaleDelay *metav1.Duration
+	// The period since last upscale, before another upscale can be performed in horizontal pod autoscaler (default 3m0s).
+	// +optional
+	UpscaleDelay *metav1.Duration
 }
 
 // KubeSchedulerConfig contains configuration settings for the kube-scheduler.
@@ -1048,11 +1236,205 @@ type KubeSchedulerConfig struct {
 // KubeProxyConfig contains configuration settings for the kube-proxy.
 type KubeProxyConfig struct {
 	KubernetesConfig
+	// Mode specifies which proxy mode to use (default IPTables).
+	// +optional
+	Mode *ProxyMode
 }
 
+// ProxyMode available in Linux platform: 'IPTables', 'IPVS'.
+type ProxyMode string
+
+const (
+	// ProxyModeIPTables uses iptables as proxy implementation.
+	ProxyModeIPTables ProxyMode = "IPTables"
+	// ProxyModeIPVS uses ipvs as proxy implementation.
+	ProxyModeIPVS ProxyMode = "IPVS"
+)
+
 // KubeletConfig contains configuration settings for the kubelet.
 type KubeletConfig struct {
 	KubernetesConfig
+	// CPUManagerPolicy allows to set alternative CPU management policies (default none).
+	// +optional
+	CPUManagerPolicy *string
+	// EvictionHard describes a set of eviction thresholds (e.g. memory.available<1Gi) that if met would trigger a pod eviction.
+	// +optional
+	EvictionHard *KubeletConfigEviction
+	// EvictionSoft describes a set of eviction thresholds (e.g. memory.available<1.5Gi) that if met over a corresponding grace period would trigger a pod eviction.
+	// +optional
+	EvictionSoft *KubeletConfigEviction
+	// EvictionSoftGracePeriod describes a set of eviction grace periods (e.g. memory.available=1m30s) that correspond to how long a soft eviction threshold must hold before triggering a pod eviction.
+	// +optional
+	EvictionSoftGracePeriod *KubeletConfigEvictionSoftGracePeriod
+	// EvictionPressureTransitionPeriod is the duration for which the kubelet has to wait before transitioning out of an eviction pressure condition.
+	// +optional
+	EvictionPressureTransitionPeriod *metav1.Duration
+	// EvictionMaxPodGracePeriod is the maximum allowed grace period (in seconds) to use when terminating pods in response to a soft eviction threshold being met.
+	// +optional
+	EvictionMaxPodGracePeriod *int32
+	// MaxPods is the maximum number of Pods that are allowed by the Kubelet (default 110).
+	// +optional
+	MaxPods *int32
+}
+
+// KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.
+type KubeletConfigEviction struct {
+	// MemoryAvailable is the threshold for the free memory on the host server.
+	// +optional
+	MemoryAvailable *string
+	// ImageFSAvailable is the threshold for the free disk space in the imagefs filesystem (docker images and container writable layers).
+	// +optional
+	ImageFSAvailable *string
+	// ImageFSInodesFree is the threshold for the available inodes in the imagefs filesystem.
+	// +optional
+	ImageFSInodesFree *string
+	// NodeFSAvailable is the threshold for the free disk space in the nodefs filesystem (docker volumes, logs, etc).
+	// +optional
+	NodeFSAvailable *string
+	// NodeFSInodesFree is the threshold for the available inodes in the nodefs filesystem.
+	// +optional
+	NodeFSInodesFree *string
+}
+
+// KubeletConfigEvictionSoftGracePeriod contains grace periods for kubelet eviction thresholds.
+type KubeletConfigEvictionSoftGracePeriod struct {
+	// MemoryAvailable is the grace period for the MemoryAvailable eviction threshold.
+	// +optional
+	MemoryAvailable *metav1.Duration
+	// ImageFSAvailable is the grace period for the ImageFSAvailable eviction threshold.
+	// +optional
+	ImageFSAvailable *metav1.Duration
+	// ImageFSInodesFree is the grace period for the ImageFSInodesFree eviction threshold.
+	// +optional
+	ImageFSInodesFree *metav1.Duration
+	// NodeFSAvailable is the grace period for the NodeFSAvailable eviction threshold.
+	// +optional
+	NodeFSAvailable *metav1.Duration
+	// NodeFSInodesFree is the grace period for the NodeFSInodesFree eviction threshold.
+	// +optional
+	NodeFSInodesFree *metav1.Duration
+}
+
+const (
+	// KubeletCPUManagerPolicyNone is a constant for the 'none' CPU manager policy of the kubelet.
+	KubeletCPUManagerPolicyNone = "none"
+	// KubeletCPUManagerPolicyStatic is a constant for the 'static' CPU manager policy of the kubelet.
+	KubeletCPUManagerPolicyStatic = "static"
+)
+
+// Monitoring contains information about the monitoring configuration of the Shoot.
+type Monitoring struct {
+	// Alerting contains information about the alerting configuration of the Shoot.
+	// +optional
+	Alerting *Alerting
+	// Rules contains information about additional Prometheus recording and alerting rules for the Shoot.
+	// +optional
+	Rules *MonitoringRules
+}
+
+// MonitoringRules contains information about additional Prometheus recording and alerting rules for the Shoot.
+type MonitoringRules struct {
+	// ConfigMapRef is a reference to a ConfigMap object in the same namespace as the Shoot which contains Prometheus
+	// rule files (one rule file per key).
+	ConfigMapRef corev1.LocalObjectReference
+}
+
+// Alerting contains information about the alerting configuration of the Shoot.
+type Alerting struct {
+	// Receivers is a list of receivers which are notified about the alerts of the Shoot.
+	// +optional
+	Receivers []AlertReceiver
+}
+
+// AlertReceiver contains information about a receiver which is notified about the alerts of the Shoot. Exactly one of
+// Email, Slack, PagerDuty and Webhook must be set.
+type AlertReceiver struct {
+	// Name is the name of the receiver.
+	Name string
+	// Severities is the list of alert severities the receiver is notified about (default ["critical", "blocker"]).
+	// +optional
+	Severities []string
+	// Email contains the configuration of an email receiver.
+	// +optional
+	Email *EmailReceiver
+	// Slack contains the configuration of a Slack receiver.
+	// +optional
+	Slack *SlackReceiver
+	// PagerDuty contains the configuration of a PagerDuty receiver.
+	// +optional
+	PagerDuty *PagerDutyReceiver
+	// Webhook contains the configuration of a generic webhook receiver.
+	// +optional
+	Webhook *WebhookReceiver
+}
+
+// EmailReceiver contains the configuration of an email receiver. The emails are sent via the SMTP server configured
+// for the Gardener.
+type EmailReceiver struct {
+	// To is the list of email addresses to send notifications to.
+	To []string
+}
+
+// SlackReceiver contains the configuration of a Slack receiver.
+type SlackReceiver struct {
+	// SecretRef is a reference to a Secret object in the same namespace as the Shoot which contains the URL of the
+	// Slack webhook in its `url` key.
+	SecretRef corev1.LocalObjectReference
+	// Channel is the Slack channel or user to send notifications to.
+	// +optional
+	Channel *string
+}
+
+// PagerDutyReceiver contains the configuration of a PagerDuty receiver.
+type PagerDutyReceiver struct {
+	// SecretRef is a reference to a Secret object in the same namespace as the Shoot which contains the integration
+	// key of the PagerDuty Events API v2 in its `routingKey` key.
+	SecretRef corev1.LocalObjectReference
+}
+
+// WebhookReceiver contains the configuration of a generic webhook receiver.
+type WebhookReceiver struct {
+	// SecretRef is a reference to a Secret object in the same namespace as the Shoot which contains the URL of the
+	// webhook in its `url` key.
+	SecretRef corev1.LocalObjectReference
+}
+
+const (
+	// AlertReceiverSecretURLKey is the key in a Secret which contains the URL of a Slack or webhook receiver.
+	AlertReceiverSecretURLKey = "url"
+	// AlertReceiverSecretRoutingKeyKey is the key in a Secret which contains the integration key of a PagerDuty receiver.
+	AlertReceiverSecretRoutingKeyKey = "routingKey"
+	// AlertSeverityWarning is a constant for the 'warning' alert severity.
+	AlertSeverityWarning = "warning"
+	// AlertSeverityCritical is a constant for the 'critical' alert severity.
+	AlertSeverityCritical = "critical"
+	// AlertSeverityBlocker is a constant for the 'blocker' alert severity.
+	AlertSeverityBlocker = "blocker"
+)
+
+// Hibernation contains information whether the Shoot is suspended or not.
+type Hibernation struct {
+	// Enabled is true if the Shoot's desired state is hibernated, false otherwise.
+	Enabled bool
+	// Schedules determine the hibernation schedules.
+	// +optional
+	Schedules []HibernationSchedule
+}
+
+// HibernationSchedule determines the hibernation schedule of a Shoot.
+// A Shoot will be regularly hibernated at each start time and will be woken up at each end time.
+// Start or End can be omitted, though at least one of them has to be specified.
+type HibernationSchedule struct {
+	// Start is a Cron spec at which time a Shoot will be hibernated.
+	// +optional
+	Start *string
+	// End is a Cron spec at which time a Shoot will be woken up.
+	// +optional
+	End *string
+	// Location is the time location in which both start and end shall be evaluated.
+	// Defaults to UTC.
+	// +optional
+	Location *string
 }
 
 // Maintenance contains information about the time window for maintenance operations and which
@@ -1097,6 +1479,14 @@ const (
 // Shoot Status Types //
 ////////////////////////
 
+// Certificate holds information about a certificate used by the control plane of a Shoot cluster.
+type Certificate struct {
+	// Name is the name of the secret in the Shoot namespace of the Seed cluster which contains the certificate.
+	Name string
+	// NotAfter is the time at which the certificate expires.
+	NotAfter metav1.Time
+}
+
 // Gardener holds the information about the Gardener
 type Gardener struct {
 	// ID is the Docker container id of the Gardener which last acted on a Shoot cluster.
@@ -1134,6 +1524,8 @@ const (
 	ShootLastOperationTypeUpdate ShootLastOperationType = "Update"
 	// ShootLastOperationTypeDelete indicates a 'delete' operation.
 	ShootLastOperationTypeDelete ShootLastOperationType = "Delete"
+	// ShootLastOperationTypeMigrate indicates a 'migrate' operation, i.e. the control plane is moved to another Seed cluster.
+	ShootLastOperationTypeMigrate ShootLastOperationType = "Migrate"
 )
 
 // ShootLastOperationState is a string alias.
@@ -1186,6 +1578,18 @@ const (
 	ShootEventDeleted = "DeletedShoot"
 	// ShootEventDeleteError indicates that the a Delete operation failed.
 	ShootEventDeleteError = "DeleteError"
+	// ShootEventHibernationEnabled indicates that hibernation started.
+	ShootEventHibernationEnabled = "HibernationEnabled"
+	// ShootEventHibernationDisabled indicates that hibernation ended.
+	ShootEventHibernationDisabled = "HibernationDisabled"
+	// ShootEventHibernationError indicates that a hibernation schedule could not be applied.
+	ShootEventHibernationError = "HibernationError"
+	// ShootEventCertificatesRotated indicates that the certificates of a Shoot have been rotated.
+	ShootEventCertificatesRotated = "CertificatesRotated"
+	// ShootEventEtcdEncryptionKeyRotated indicates that the etcd encryption key of a Shoot has been rotated.
+	ShootEventEtcdEncryptionKeyRotated = "EtcdEncryptionKeyRotated"
+	// ShootEventEtcdRestored indicates that the etcd of a Shoot has been restored from a backup snapshot.
+	ShootEventEtcdRestored = "EtcdRestored"
 	// ShootEventMaintenanceDone indicates that a maintenance operation has been performed.
 	ShootEventMaintenanceDone = "MaintenanceDone"
 	// ShootEventMaintenanceError indicates that a maintenance operation has failed.
@@ -1225,6 +1629,8 @@ type ConditionType string
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable ConditionType = "Available"
+	// ShootCertificatesValid is a constant for a condition type indicating the validity of the certificates.
+	ShootCertificatesValid ConditionType = "CertificatesValid"
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the control plane health.
 	ShootControlPlaneHealthy ConditionType = "ControlPlaneHealthy"
 	// ShootEveryNodeReady is a constant for a condition type indicating the node health.
@@ -17,6 +17,7 @@ package v1beta1
 import (
 	"github.com/gardener/gardener/pkg/utils"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
@@ -91,6 +92,14 @@ func SetDefaults_Shoot(obj *Shoot) {
 		obj.Spec.Kubernetes.AllowPrivilegedContainers = &trueVar
 	}
 
+	if kubeAPIServer := obj.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil {
+		for i, plugin := range kubeAPIServer.AdmissionPlugins {
+			if plugin.Enabled == nil {
+				kubeAPIServer.AdmissionPlugins[i].Enabled = &trueVar
+			}
+		}
+	}
+
 	if obj.Spec.Maintenance == nil {
 		begin, end := utils.ComputeRandomTimeWindow()
 		obj.Spec.Maintenance = &Maintenance{
@@ -136,6 +145,18 @@ func SetDefaults_Seed(obj *Seed) {
 	}
 }
 
+// SetDefaults_Worker sets default values for Worker objects.
+func SetDefaults_Worker(obj *Worker) {
+	if obj.MaxSurge == nil {
+		maxSurge := intstr.FromInt(DefaultWorkerMaxSurge)
+		obj.MaxSurge = &maxSurge
+	}
+	if obj.MaxUnavailable == nil {
+		maxUnavailable := intstr.FromInt(DefaultWorkerMaxUnavailable)
+		obj.MaxUnavailable = &maxUnavailable
+	}
+}
+
 // SetDefaults_SecretBinding sets default values for SecretBinding objects.
 func SetDefaults_SecretBinding(obj *SecretBinding) {
 	if len(obj.SecretRef.Namespace) == 0 {
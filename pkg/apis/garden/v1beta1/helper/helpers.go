@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/utils"
@@ -271,3 +272,62 @@ func DetermineLatestKubernetesVersion(cloudProfile gardenv1beta1.CloudProfile, c
 
 	return false, "", nil
 }
+
+// IsShootHibernated returns true if the hibernation of the given <shoot> is enabled, false otherwise.
+func IsShootHibernated(shoot *gardenv1beta1.Shoot) bool {
+	return shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled
+}
+
+// DetermineHibernationActivation checks whether one of the given hibernation <schedules> has been activated within
+// the time interval (<from>, <to>]. If that is the case, true will be returned together with the desired
+// hibernation state (true if the Shoot shall be hibernated, false if it shall be woken up). If multiple
+// activations happened in the interval then the latest one wins; a wake-up wins over a simultaneous hibernation.
+func DetermineHibernationActivation(schedules []gardenv1beta1.HibernationSchedule, from, to time.Time) (bool, bool, error) {
+	var (
+		activated  bool
+		hibernate  bool
+		activation time.Time
+	)
+
+	for _, schedule := range schedules {
+		location := time.UTC
+		if schedule.Location != nil {
+			loc, err := time.LoadLocation(*schedule.Location)
+			if err != nil {
+				return false, false, err
+			}
+			location = loc
+		}
+
+		for _, spec := range []struct {
+			cron      *string
+			hibernate bool
+		}{
+			{schedule.Start, true},
+			{schedule.End, false},
+		} {
+			if spec.cron == nil {
+				continue
+			}
+
+			cronSchedule, err := utils.ParseCronSchedule(*spec.cron)
+			if err != nil {
+				return false, false, err
+			}
+
+			latest := time.Time{}
+			for next := cronSchedule.Next(from.In(location)); !next.IsZero() && !next.After(to); next = cronSchedule.Next(next) {
+				latest = next
+			}
+			if latest.IsZero() {
+				continue
+			}
+
+			if !activated || latest.After(activation) || (latest.Equal(activation) && !spec.hibernate) {
+				activated, hibernate, activation = true, spec.hibernate, latest
+			}
+		}
+	}
+
+	return activated, hibernate, nil
+}
@@ -15,6 +15,8 @@
 package helper_test
 
 import (
+	"time"
+
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	. "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 
@@ -50,4 +52,76 @@ var _ = Describe("helper", func() {
 			Expect(cond).To(BeNil())
 		})
 	})
+
+	Describe("#IsShootHibernated", func() {
+		It("should return false if no hibernation section is specified", func() {
+			Expect(IsShootHibernated(&gardenv1beta1.Shoot{})).To(BeFalse())
+		})
+
+		It("should return whether the hibernation is enabled", func() {
+			shoot := &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Hibernation: &gardenv1beta1.Hibernation{Enabled: true},
+				},
+			}
+
+			Expect(IsShootHibernated(shoot)).To(BeTrue())
+
+			shoot.Spec.Hibernation.Enabled = false
+			Expect(IsShootHibernated(shoot)).To(BeFalse())
+		})
+	})
+
+	Describe("#DetermineHibernationActivation", func() {
+		var (
+			start     = "0 18 * * 1-5"
+			end       = "0 8 * * 1-5"
+			location  = "Europe/Berlin"
+			schedules = []gardenv1beta1.HibernationSchedule{
+				{Start: &start, End: &end, Location: &location},
+			}
+			// Wednesday, 2018-05-02, 16:00 UTC equals 18:00 in Berlin.
+			hibernationTime = time.Date(2018, 5, 2, 16, 0, 0, 0, time.UTC)
+			wakeUpTime      = time.Date(2018, 5, 3, 6, 0, 0, 0, time.UTC)
+		)
+
+		It("should return false if no schedule has been activated", func() {
+			activated, _, err := DetermineHibernationActivation(schedules, hibernationTime, hibernationTime.Add(time.Hour))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activated).To(BeFalse())
+		})
+
+		It("should determine that the Shoot shall be hibernated", func() {
+			activated, hibernate, err := DetermineHibernationActivation(schedules, hibernationTime.Add(-time.Minute), hibernationTime)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activated).To(BeTrue())
+			Expect(hibernate).To(BeTrue())
+		})
+
+		It("should determine that the Shoot shall be woken up", func() {
+			activated, hibernate, err := DetermineHibernationActivation(schedules, wakeUpTime.Add(-time.Minute), wakeUpTime.Add(time.Minute))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activated).To(BeTrue())
+			Expect(hibernate).To(BeFalse())
+		})
+
+		It("should let the latest activation win", func() {
+			activated, hibernate, err := DetermineHibernationActivation(schedules, hibernationTime.Add(-time.Minute), wakeUpTime.Add(time.Minute))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activated).To(BeTrue())
+			Expect(hibernate).To(BeFalse())
+		})
+
+		It("should return an error for invalid schedules", func() {
+			invalid := "foo"
+
+			_, _, err := DetermineHibernationActivation([]gardenv1beta1.HibernationSchedule{{Start: &invalid}}, hibernationTime, wakeUpTime)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 ////////////////////////////////////////////////////
@@ -182,6 +183,9 @@ type GCPConstraints struct {
 	MachineImages []GCPMachineImage `json:"machineImages"`
 	// MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.
 	MachineTypes []MachineType `json:"machineTypes"`
+	// PreemptibleMachineTypes contains the names of the machine types which may be used for preemptible VMs.
+	// +optional
+	PreemptibleMachineTypes []string `json:"preemptibleMachineTypes,omitempty"`
 	// VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.
 	VolumeTypes []VolumeType `json:"volumeTypes"`
 	// Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.
@@ -410,6 +414,9 @@ type Quota struct {
 	// Spec defines the Quota constraints.
 	// +optional
 	Spec QuotaSpec `json:"spec,omitempty"`
+	// Status contains the most recently observed usage of the Quota.
+	// +optional
+	Status QuotaStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -435,6 +442,27 @@ type QuotaSpec struct {
 	Scope QuotaScope `json:"scope"`
 }
 
+// QuotaStatus holds the most recently observed usage of a Quota.
+type QuotaStatus struct {
+	// Used is the amount of the constrained resources which is allocated by all Shoots consuming the Quota.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
+	// Shoots is the list of Shoots which consume the Quota together with the resources each of them allocates.
+	// +optional
+	Shoots []QuotaShootUsage `json:"shoots,omitempty"`
+}
+
+// QuotaShootUsage is the amount of the constrained resources which is allocated by a single Shoot.
+type QuotaShootUsage struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// Used is the amount of the constrained resources which is allocated by the Shoot.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
+}
+
 // QuotaScope is a string alias.
 type QuotaScope string
 
@@ -521,12 +549,19 @@ type ShootSpec struct {
 	Cloud Cloud `json:"cloud"`
 	// DNS contains information about the DNS settings of the Shoot.
 	DNS DNS `json:"dns"`
+	// Hibernation contains information whether the Shoot is suspended or not and the schedules which
+	// determine when it is automatically hibernated or woken up.
+	// +optional
+	Hibernation *Hibernation `json:"hibernation,omitempty"`
 	// Kubernetes contains the version and configuration settings of the control plane components.
 	Kubernetes Kubernetes `json:"kubernetes"`
 	// Maintenance contains information about the time window for maintenance operations and which
 	// operations should be performed.
 	// +optional
 	Maintenance *Maintenance `json:"maintenance,omitempty"`
+	// Monitoring contains information about the monitoring configuration of the Shoot.
+	// +optional
+	Monitoring *Monitoring `json:"monitoring,omitempty"`
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -553,6 +588,13 @@ type ShootStatus struct {
 	// UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters.
 	// It is used to compute unique hashes.
 	UID types.UID `json:"uid"`
+	// Seed is the name of the Seed cluster which currently hosts the control plane of the Shoot cluster. If it differs
+	// from the Seed cluster referenced in the specification then the control plane is migrated.
+	// +optional
+	Seed *string `json:"seed,omitempty"`
+	// Certificates contains the expiration dates of the certificates used by the control plane of the Shoot cluster.
+	// +optional
+	Certificates []Certificate `json:"certificates,omitempty"`
 }
 
 ///////////////////////////////
@@ -586,6 +628,10 @@ type Cloud struct {
 	// Vagrant contains the Shoot specification for the Vagrant local provider.
 	// +optional
 	Vagrant *VagrantLocal `json:"vagrant,omitempty"`
+	// SequentialWorkerRollout indicates whether changes to the worker groups are rolled out one worker group after
+	// the other (in the order of their declaration) instead of all at once.
+	// +optional
+	SequentialWorkerRollout *bool `json:"sequentialWorkerRollout,omitempty"`
 }
 
 // K8SNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.
@@ -647,6 +693,10 @@ type AWSWorker struct {
 	VolumeType string `json:"volumeType"`
 	// VolumeSize is the size of the root volume.
 	VolumeSize string `json:"volumeSize"`
+	// MachineImage holds information about the machine image to use for this worker group.
+	// It overrides the cluster-wide machine image if set.
+	// +optional
+	MachineImage *AWSMachineImage `json:"machineImage,omitempty"`
 }
 
 // AzureCloud contains the Shoot specification for Azure.
@@ -697,6 +747,10 @@ type AzureWorker struct {
 	VolumeType string `json:"volumeType"`
 	// VolumeSize is the size of the root volume.
 	VolumeSize string `json:"volumeSize"`
+	// MachineImage holds information about the machine image to use for this worker group.
+	// It overrides the cluster-wide machine image if set.
+	// +optional
+	MachineImage *AzureMachineImage `json:"machineImage,omitempty"`
 }
 
 // GCPCloud contains the Shoot specification for GCP.
@@ -737,6 +791,13 @@ type GCPWorker struct {
 	VolumeType string `json:"volumeType"`
 	// VolumeSize is the size of the root volume.
 	VolumeSize string `json:"volumeSize"`
+	// MachineImage holds information about the machine image to use for this worker group.
+	// It overrides the cluster-wide machine image if set.
+	// +optional
+	MachineImage *GCPMachineImage `json:"machineImage,omitempty"`
+	// Preemptible indicates whether the machines of this worker group shall be preemptible VMs.
+	// +optional
+	Preemptible *bool `json:"preemptible,omitempty"`
 }
 
 // OpenStackCloud contains the Shoot specification for OpenStack.
@@ -777,6 +838,10 @@ type OpenStackRouter struct {
 // OpenStackWorker is the definition of a worker group.
 type OpenStackWorker struct {
 	Worker `json:",inline"`
+	// MachineImage holds information about the machine image to use for this worker group.
+	// It overrides the cluster-wide machine image if set.
+	// +optional
+	MachineImage *OpenStackMachineImage `json:"machineImage,omitempty"`
 }
 
 // VagrantLocal contains the Shoot specification for local Vagrant provider.
@@ -802,6 +867,28 @@ type Worker struct {
 	AutoScalerMin int `json:"autoScalerMin"`
 	// AutoScalerMin is the maximum number of VMs to create.
 	AutoScalerMax int `json:"autoScalerMax"`
+	// Labels is a map of key/value pairs which are added to the nodes of the worker group.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations is a map of key/value pairs which are added to the nodes of the worker group.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Taints is a list of taints which are added to the nodes of the worker group.
+	// +optional
+	Taints []corev1.Taint `json:"taints,omitempty"`
+	// KubeletVersion is the version of the kubelet running on the nodes of the worker group. It must not be
+	// newer than the version of the control plane and at most two minor versions older. It defaults to the
+	// Kubernetes version of the Shoot cluster if not set.
+	// +optional
+	KubeletVersion *string `json:"kubeletVersion,omitempty"`
+	// MaxSurge is the maximum number of VMs that are created during a rolling update of the worker group (per zone).
+	// It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of VMs that can be unavailable during a rolling update of the worker group
+	// (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
@@ -986,8 +1073,82 @@ type KubeAPIServerConfig struct {
 	// OIDCConfig contains configuration settings for the OIDC provider.
 	// +optional
 	OIDCConfig *OIDCConfig `json:"oidcConfig,omitempty"`
+	// AuditConfig contains configuration settings for the audit of the kube-apiserver.
+	// +optional
+	AuditConfig *AuditConfig `json:"auditConfig,omitempty"`
+	// AdmissionPlugins contains the list of user-defined admission plugins (additional to those managed by Gardener)
+	// and, if desired, the corresponding configuration.
+	// +optional
+	AdmissionPlugins []AdmissionPlugin `json:"admissionPlugins,omitempty"`
 }
 
+// AdmissionPlugin contains information about a specific admission plugin and its corresponding configuration.
+type AdmissionPlugin struct {
+	// Name is the name of the plugin.
+	Name string `json:"name"`
+	// Enabled indicates whether the plugin is enabled or disabled (default true).
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Config is the configuration of the plugin (a YAML or JSON document).
+	// +optional
+	Config *string `json:"config,omitempty"`
+}
+
+// AuditConfig contains settings for the audit of the kube-apiserver.
+type AuditConfig struct {
+	// AuditPolicy contains configuration settings for the audit policy of the kube-apiserver.
+	// +optional
+	AuditPolicy *AuditPolicy `json:"auditPolicy,omitempty"`
+	// Log contains configuration settings for the log backend of the audit. If set, audit events are written
+	// to the standard output of the kube-apiserver container.
+	// +optional
+	Log *AuditLogBackend `json:"log,omitempty"`
+	// Webhook contains configuration settings for the webhook backend of the audit. If set, audit events are
+	// sent to the configured remote API.
+	// +optional
+	Webhook *AuditWebhookBackend `json:"webhook,omitempty"`
+}
+
+// AuditPolicy contains configuration settings for the audit policy of the kube-apiserver.
+type AuditPolicy struct {
+	// ConfigMapRef is a reference to a ConfigMap object in the same namespace as the Shoot which contains the
+	// audit policy for the kube-apiserver in its `policy` key.
+	// +optional
+	ConfigMapRef *corev1.LocalObjectReference `json:"configMapRef,omitempty"`
+}
+
+// AuditLogBackend contains configuration settings for the log backend of the audit.
+type AuditLogBackend struct {
+	// Format is the format of the written audit events. Known formats are "legacy" and "json" (default "json").
+	// +optional
+	Format *string `json:"format,omitempty"`
+}
+
+// AuditWebhookBackend contains configuration settings for the webhook backend of the audit.
+type AuditWebhookBackend struct {
+	// KubeconfigSecretRef is a reference to a Secret object in the same namespace as the Shoot which contains a
+	// kubeconfig for the remote API in its `kubeconfig` key.
+	KubeconfigSecretRef corev1.LocalObjectReference `json:"kubeconfigSecretRef"`
+	// Mode is the strategy for sending audit events. Known modes are "batch" and "blocking" (default "batch").
+	// +optional
+	Mode *string `json:"mode,omitempty"`
+}
+
+const (
+	// AuditPolicyConfigMapDataKey is the key in a ConfigMap which contains the audit policy.
+	AuditPolicyConfigMapDataKey = "policy"
+	// AuditWebhookKubeconfigSecretDataKey is the key in a Secret which contains the kubeconfig for the audit webhook.
+	AuditWebhookKubeconfigSecretDataKey = "kubeconfig"
+	// AuditLogFormatJSON is a constant for the 'json' audit log format.
+	AuditLogFormatJSON = "json"
+	// AuditLogFormatLegacy is a constant for the 'legacy' audit log format.
+	AuditLogFormatLegacy = "legacy"
+	// AuditWebhookModeBatch is a constant for the 'batch' audit webhook mode.
+	AuditWebhookModeBatch = "batch"
+	// AuditWebhookModeBlocking is a constant for the 'blocking' audit webhook mode.
+	AuditWebhookModeBlocking = "blocking"
+)
+
 // OIDCConfig contains configuration settings for the OIDC provider.
 // Note: Descriptions were taken from the Kubernetes documentation.
 type OIDCConfig struct {
@@ -1017,6 +1178,27 @@ type OIDCConfig struct {
 // KubeControllerManagerConfig contains configuration settings for the kube-controller-manager.
 type KubeControllerManagerConfig struct {
 	KubernetesConfig `json:",inline"`
+	// HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
+	// +optional
+	HorizontalPodAutoscalerConfig *HorizontalPodAutoscalerConfig `json:"horizontalPodAutoscaler,omitempty"`
+	// NodeMonitorGracePeriod is the amount of time which a running node is allowed to be unresponsive before it is
+	// marked unhealthy (default 40s).
+	// +optional
+	NodeMonitorGracePeriod *metav1.Duration `json:"nodeMonitorGracePeriod,omitempty"`
+}
+
+// HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
+// Note: Descriptions were taken from the Kubernetes documentation.
+type HorizontalPodAutoscalerConfig struct {
+	// The period for syncing the number of pods in horizontal pod autoscaler (default 30s).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// The period since last downscale, before another downscale can be performed in horizontal pod autoscaler (default 5m0s).
+	// +optional
+	DownscaleDelay *metav1.Duration `json:"downscaleDelay,omitempty"`
+	// The period since last upscale, before another upscale can be performed in horizontal pod autoscaler (default 3m0s).
+	// +optional
+	UpscaleDelay *metav1.Duration `json:"upscaleDelay,omitempty"`
 }
 
 // KubeSchedulerConfig contains configuration settings for the kube-scheduler.
@@ -1027,11 +1209,205 @@ type KubeSchedulerConfig struct {
 // KubeProxyConfig contains configuration settings for the kube-proxy.
 type KubeProxyConfig struct {
 	KubernetesConfig `json:",inline"`
+	// Mode specifies which proxy mode to use (default IPTables).
+	// +optional
+	Mode *ProxyMode `json:"mode,omitempty"`
 }
 
+// ProxyMode available in Linux platform: 'IPTables', 'IPVS'.
+type ProxyMode string
+
+const (
+	// ProxyModeIPTables uses iptables as proxy implementation.
+	ProxyModeIPTables ProxyMode = "IPTables"
+	// ProxyModeIPVS uses ipvs as proxy implementation.
+	ProxyModeIPVS ProxyMode = "IPVS"
+)
+
 // KubeletConfig contains configuration settings for the kubelet.
 type KubeletConfig struct {
 	KubernetesConfig `json:",inline"`
+	// CPUManagerPolicy allows to set alternative CPU management policies (default none).
+	// +optional
+	CPUManagerPolicy *string `json:"cpuManagerPolicy,omitempty"`
+	// EvictionHard describes a set of eviction thresholds (e.g. memory.available<1Gi) that if met would trigger a pod eviction.
+	// +optional
+	EvictionHard *KubeletConfigEviction `json:"evictionHard,omitempty"`
+	// EvictionSoft describes a set of eviction thresholds (e.g. memory.available<1.5Gi) that if met over a corresponding grace period would trigger a pod eviction.
+	// +optional
+	EvictionSoft *KubeletConfigEviction `json:"evictionSoft,omitempty"`
+	// EvictionSoftGracePeriod describes a set of eviction grace periods (e.g. memory.available=1m30s) that correspond to how long a soft eviction threshold must hold before triggering a pod eviction.
+	// +optional
+	EvictionSoftGracePeriod *KubeletConfigEvictionSoftGracePeriod `json:"evictionSoftGracePeriod,omitempty"`
+	// EvictionPressureTransitionPeriod is the duration for which the kubelet has to wait before transitioning out of an eviction pressure condition.
+	// +optional
+	EvictionPressureTransitionPeriod *metav1.Duration `json:"evictionPressureTransitionPeriod,omitempty"`
+	// EvictionMaxPodGracePeriod is the maximum allowed grace period (in seconds) to use when terminating pods in response to a soft eviction threshold being met.
+	// +optional
+	EvictionMaxPodGracePeriod *int32 `json:"evictionMaxPodGracePeriod,omitempty"`
+	// MaxPods is the maximum number of Pods that are allowed by the Kubelet (default 110).
+	// +optional
+	MaxPods *int32 `json:"maxPods,omitempty"`
+}
+
+// KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.
+type KubeletConfigEviction struct {
+	// MemoryAvailable is the threshold for the free memory on the host server.
+	// +optional
+	MemoryAvailable *string `json:"memoryAvailable,omitempty"`
+	// ImageFSAvailable is the threshold for the free disk space in the imagefs filesystem (docker images and container writable layers).
+	// +optional
+	ImageFSAvailable *string `json:"imageFSAvailable,omitempty"`
+	// ImageFSInodesFree is the threshold for the available inodes in the imagefs filesystem.
+	// +optional
+	ImageFSInodesFree *string `json:"imageFSInodesFree,omitempty"`
+	// NodeFSAvailable is the threshold for the free disk space in the nodefs filesystem (docker volumes, logs, etc).
+	// +optional
+	NodeFSAvailable *string `json:"nodeFSAvailable,omitempty"`
+	// NodeFSInodesFree is the threshold for the available inodes in the nodefs filesystem.
+	// +optional
+	NodeFSInodesFree *string `json:"nodeFSInodesFree,omitempty"`
+}
+
+// KubeletConfigEvictionSoftGracePeriod contains grace periods for kubelet eviction thresholds.
+type KubeletConfigEvictionSoftGracePeriod struct {
+	// MemoryAvailable is the grace period for the MemoryAvailable eviction threshold.
+	// +optional
+	MemoryAvailable *metav1.Duration `json:"memoryAvailable,omitempty"`
+	// ImageFSAvailable is the grace period for the ImageFSAvailable eviction threshold.
+	// +optional
+	ImageFSAvailable *metav1.Duration `json:"imageFSAvailable,omitempty"`
+	// ImageFSInodesFree is the grace period for the ImageFSInodesFree eviction threshold.
+	// +optional
+	ImageFSInodesFree *metav1.Duration `json:"imageFSInodesFree,omitempty"`
+	// NodeFSAvailable is the grace period for the NodeFSAvailable eviction threshold.
+	// +optional
+	NodeFSAvailable *metav1.Duration `json:"nodeFSAvailable,omitempty"`
+	// NodeFSInodesFree is the grace period for the NodeFSInodesFree eviction threshold.
+	// +optional
+	NodeFSInodesFree *metav1.Duration `json:"nodeFSInodesFree,omitempty"`
+}
+
+const (
+	// KubeletCPUManagerPolicyNone is a constant for the 'none' CPU manager policy of the kubelet.
+	KubeletCPUManagerPolicyNone = "none"
+	// KubeletCPUManagerPolicyStatic is a constant for the 'static' CPU manager policy of the kubelet.
+	KubeletCPUManagerPolicyStatic = "static"
+)
+
+// Monitoring contains information about the monitoring configuration of the Shoot.
+type Monitoring struct {
+	// Alerting contains information about the alerting configuration of the Shoot.
+	// +optional
+	Alerting *Alerting `json:"alerting,omitempty"`
+	// Rules contains information about additional Prometheus recording and alerting rules for the Shoot.
+	// +optional
+	Rules *MonitoringRules `json:"rules,omitempty"`
+}
+
+// MonitoringRules contains information about additional Prometheus recording and alerting rules for the Shoot.
+type MonitoringRules struct {
+	// ConfigMapRef is a reference to a ConfigMap object in the same namespace as the Shoot which contains Prometheus
+	// rule files (one rule file per key).
+	ConfigMapRef corev1.LocalObjectReference `json:"configMapRef"`
+}
+
+// Alerting contains information about the alerting configuration of the Shoot.
+type Alerting struct {
+	// Receivers is a list of receivers which are notified about the alerts of the Shoot.
+	// +optional
+	Receivers []AlertReceiver `json:"receivers,omitempty"`
+}
+
+// AlertReceiver contains information about a receiver which is notified about the alerts of the Shoot. Exactly one of
+// Email, Slack, PagerDuty and Webhook must be set.
+type AlertReceiver struct {
+	// Name is the name of the receiver.
+	Name string `json:"name"`
+	// Severities is the list of alert severities the receiver is notified about (default ["critical", "blocker"]).
+	// +optional
+	Severities []string `json:"severities,omitempty"`
+	// Email contains the configuration of an email receiver.
+	// +optional
+	Email *EmailReceiver `json:"email,omitempty"`
+	// Slack contains the configuration of a Slack receiver.
+	// +optional
+	Slack *SlackReceiver `json:"slack,omitempty"`
+	// PagerDuty contains the configuration of a PagerDuty receiver.
+	// +optional
+	PagerDuty *PagerDutyReceiver `json:"pagerDuty,omitempty"`
+	// Webhook contains the configuration of a generic webhook receiver.
+	// +optional
+	Webhook *WebhookReceiver `json:"webhook,omitempty"`
+}
+
+// EmailReceiver contains the configuration of an email receiver. The emails are sent via the SMTP server configured
+// for the Gardener.
+type EmailReceiver struct {
+	// To is the list of email addresses to send notifications to.
+	To []string `json:"to"`
+}
+
+// SlackReceiver contains the configuration of a Slack receiver.
+type SlackReceiver struct {
+	// SecretRef is a reference to a Secret object in the same namespace as the Shoot which contains the URL of the
+	// Slack webhook in its `url` key.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+	// Channel is the Slack channel or user to send notifications to.
+	// +optional
+	Channel *string `json:"channel,omitempty"`
+}
+
+// PagerDutyReceiver contains the configuration of a PagerDuty receiver.
+type PagerDutyReceiver struct {
+	// SecretRef is a reference to a Secret object in the same namespace as the Shoot which contains the integration
+	// key of the PagerDuty Events API v2 in its `routingKey` key.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+}
+
+// WebhookReceiver contains the configuration of a generic webhook receiver.
+type WebhookReceiver struct {
+	// SecretRef is a reference to a Secret object in the same namespace as the Shoot which contains the URL of the
+	// webhook in its `url` key.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+}
+
+const (
+	// AlertReceiverSecretURLKey is the key in a Secret which contains the URL of a Slack or webhook receiver.
+	AlertReceiverSecretURLKey = "url"
+	// AlertReceiverSecretRoutingKeyKey is the key in a Secret which contains the integration key of a PagerDuty receiver.
+	AlertReceiverSecretRoutingKeyKey = "routingKey"
+	// AlertSeverityWarning is a constant for the 'warning' alert severity.
+	AlertSeverityWarning = "warning"
+	// AlertSeverityCritical is a constant for the 'critical' alert severity.
+	AlertSeverityCritical = "critical"
+	// AlertSeverityBlocker is a constant for the 'blocker' alert severity.
+	AlertSeverityBlocker = "blocker"
+)
+
+// Hibernation contains information whether the Shoot is suspended or not.
+type Hibernation struct {
+	// Enabled is true if the Shoot's desired state is hibernated, false otherwise.
+	Enabled bool `json:"enabled"`
+	// Schedules determine the hibernation schedules.
+	// +optional
+	Schedules []HibernationSchedule `json:"schedules,omitempty"`
+}
+
+// HibernationSchedule determines the hibernation schedule of a Shoot.
+// A Shoot will be regularly hibernated at each start time and will be woken up at each end time.
+// Start or End can be omitted, though at least one of them has to be specified.
+type HibernationSchedule struct {
+	// Start is a Cron spec at which time a Shoot will be hibernated.
+	// +optional
+	Start *string `json:"start,omitempty"`
+	// End is a Cron spec at which time a Shoot will be woken up.
+	// +optional
+	End *string `json:"end,omitempty"`
+	// Location is the time location in which both start and end shall be evaluated.
+	// Defaults to UTC.
+	// +optional
+	Location *string `json:"location,omitempty"`
 }
 
 // Maintenance contains information about the time window for maintenance operations and which
@@ -1070,12 +1446,26 @@ const (
 	DefaultETCDBackupIntervalSeconds = 60 * 60 * 24
 	// DefaultETCDBackupMaximum is a constant for the default number of etcd backups to keep for a Shoot cluster.
 	DefaultETCDBackupMaximum = 7
+	// DefaultWorkerMaxSurge is a constant for the default number of VMs that are created during a rolling update of a
+	// worker group.
+	DefaultWorkerMaxSurge = 1
+	// DefaultWorkerMaxUnavailable is a constant for the default number of VMs that can be unavailable during a rolling
+	// update of a worker group.
+	DefaultWorkerMaxUnavailable = 1
 )
 
 ////////////////////////
 // Shoot Status Types //
 ////////////////////////
 
+// Certificate holds information about a certificate used by the control plane of a Shoot cluster.
+type Certificate struct {
+	// Name is the name of the secret in the Shoot namespace of the Seed cluster which contains the certificate.
+	Name string `json:"name"`
+	// NotAfter is the time at which the certificate expires.
+	NotAfter metav1.Time `json:"notAfter"`
+}
+
 // Gardener holds the information about the Gardener
 type Gardener struct {
 	// ID is the Docker container id of the Gardener which last acted on a Shoot cluster.
@@ -1113,6 +1503,8 @@ const (
 	ShootLastOperationTypeUpdate ShootLastOperationType = "Update"
 	// ShootLastOperationTypeDelete indicates a 'delete' operation.
 	ShootLastOperationTypeDelete ShootLastOperationType = "Delete"
+	// ShootLastOperationTypeMigrate indicates a 'migrate' operation, i.e. the control plane is moved to another Seed cluster.
+	ShootLastOperationTypeMigrate ShootLastOperationType = "Migrate"
 )
 
 // ShootLastOperationState is a string alias.
@@ -1165,6 +1557,18 @@ const (
 	ShootEventDeleted = "DeletedShoot"
 	// ShootEventDeleteError indicates that the a Delete operation failed.
 	ShootEventDeleteError = "DeleteError"
+	// ShootEventHibernationEnabled indicates that hibernation started.
+	ShootEventHibernationEnabled = "HibernationEnabled"
+	// ShootEventHibernationDisabled indicates that hibernation ended.
+	ShootEventHibernationDisabled = "HibernationDisabled"
+	// ShootEventHibernationError indicates that a hibernation schedule could not be applied.
+	ShootEventHibernationError = "HibernationError"
+	// ShootEventCertificatesRotated indicates that the certificates of a Shoot have been rotated.
+	ShootEventCertificatesRotated = "CertificatesRotated"
+	// ShootEventEtcdEncryptionKeyRotated indicates that the etcd encryption key of a Shoot has been rotated.
+	ShootEventEtcdEncryptionKeyRotated = "EtcdEncryptionKeyRotated"
+	// ShootEventEtcdRestored indicates that the etcd of a Shoot has been restored from a backup snapshot.
+	ShootEventEtcdRestored = "EtcdRestored"
 	// ShootEventMaintenanceDone indicates that a maintenance operation has been performed.
 	ShootEventMaintenanceDone = "MaintenanceDone"
 	// ShootEventMaintenanceError indicates that a maintenance operation has failed.
@@ -1204,6 +1608,8 @@ type ConditionType string
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable ConditionType = "Available"
+	// ShootCertificatesValid is a constant for a condition type indicating the validity of the certificates.
+	ShootCertificatesValid ConditionType = "CertificatesValid"
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the control plane health.
 	ShootControlPlaneHealthy ConditionType = "ControlPlaneHealthy"
 	// ShootEveryNodeReady is a constant for a condition type indicating the node health.
@@ -13,6 +13,7 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -45,6 +46,20 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_Addon_To_v1beta1_Addon,
 		Convert_v1beta1_Addons_To_garden_Addons,
 		Convert_garden_Addons_To_v1beta1_Addons,
+		Convert_v1beta1_AdmissionPlugin_To_garden_AdmissionPlugin,
+		Convert_garden_AdmissionPlugin_To_v1beta1_AdmissionPlugin,
+		Convert_v1beta1_AlertReceiver_To_garden_AlertReceiver,
+		Convert_garden_AlertReceiver_To_v1beta1_AlertReceiver,
+		Convert_v1beta1_Alerting_To_garden_Alerting,
+		Convert_garden_Alerting_To_v1beta1_Alerting,
+		Convert_v1beta1_AuditConfig_To_garden_AuditConfig,
+		Convert_garden_AuditConfig_To_v1beta1_AuditConfig,
+		Convert_v1beta1_AuditLogBackend_To_garden_AuditLogBackend,
+		Convert_garden_AuditLogBackend_To_v1beta1_AuditLogBackend,
+		Convert_v1beta1_AuditPolicy_To_garden_AuditPolicy,
+		Convert_garden_AuditPolicy_To_v1beta1_AuditPolicy,
+		Convert_v1beta1_AuditWebhookBackend_To_garden_AuditWebhookBackend,
+		Convert_garden_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend,
 		Convert_v1beta1_AzureCloud_To_garden_AzureCloud,
 		Convert_garden_AzureCloud_To_v1beta1_AzureCloud,
 		Convert_v1beta1_AzureConstraints_To_garden_AzureConstraints,
@@ -65,6 +80,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_AzureWorker_To_v1beta1_AzureWorker,
 		Convert_v1beta1_Backup_To_garden_Backup,
 		Convert_garden_Backup_To_v1beta1_Backup,
+		Convert_v1beta1_Certificate_To_garden_Certificate,
+		Convert_garden_Certificate_To_v1beta1_Certificate,
 		Convert_v1beta1_Cloud_To_garden_Cloud,
 		Convert_garden_Cloud_To_v1beta1_Cloud,
 		Convert_v1beta1_CloudProfile_To_garden_CloudProfile,
@@ -81,6 +98,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_DNS_To_v1beta1_DNS,
 		Convert_v1beta1_DNSProviderConstraint_To_garden_DNSProviderConstraint,
 		Convert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint,
+		Convert_v1beta1_EmailReceiver_To_garden_EmailReceiver,
+		Convert_garden_EmailReceiver_To_v1beta1_EmailReceiver,
 		Convert_v1beta1_GCPCloud_To_garden_GCPCloud,
 		Convert_garden_GCPCloud_To_v1beta1_GCPCloud,
 		Convert_v1beta1_GCPConstraints_To_garden_GCPConstraints,
@@ -101,6 +120,12 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_Heapster_To_v1beta1_Heapster,
 		Convert_v1beta1_HelmTiller_To_garden_HelmTiller,
 		Convert_garden_HelmTiller_To_v1beta1_HelmTiller,
+		Convert_v1beta1_Hibernation_To_garden_Hibernation,
+		Convert_garden_Hibernation_To_v1beta1_Hibernation,
+		Convert_v1beta1_HibernationSchedule_To_garden_HibernationSchedule,
+		Convert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule,
+		Convert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig,
+		Convert_garden_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscalerConfig,
 		Convert_v1beta1_K8SNetworks_To_garden_K8SNetworks,
 		Convert_garden_K8SNetworks_To_v1beta1_K8SNetworks,
 		Convert_v1beta1_Kube2IAM_To_garden_Kube2IAM,
@@ -119,6 +144,10 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_KubeSchedulerConfig_To_v1beta1_KubeSchedulerConfig,
 		Convert_v1beta1_KubeletConfig_To_garden_KubeletConfig,
 		Convert_garden_KubeletConfig_To_v1beta1_KubeletConfig,
+		Convert_v1beta1_KubeletConfigEviction_To_garden_KubeletConfigEviction,
+		Convert_garden_KubeletConfigEviction_To_v1beta1_KubeletConfigEviction,
+		Convert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_garden_KubeletConfigEvictionSoftGracePeriod,
+		Convert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1beta1_KubeletConfigEvictionSoftGracePeriod,
 		Convert_v1beta1_Kubernetes_To_garden_Kubernetes,
 		Convert_garden_Kubernetes_To_v1beta1_Kubernetes,
 		Convert_v1beta1_KubernetesConfig_To_garden_KubernetesConfig,
@@ -139,6 +168,10 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate,
 		Convert_v1beta1_MaintenanceTimeWindow_To_garden_MaintenanceTimeWindow,
 		Convert_garden_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow,
+		Convert_v1beta1_Monitoring_To_garden_Monitoring,
+		Convert_garden_Monitoring_To_v1beta1_Monitoring,
+		Convert_v1beta1_MonitoringRules_To_garden_MonitoringRules,
+		Convert_garden_MonitoringRules_To_v1beta1_MonitoringRules,
 		Convert_v1beta1_Monocular_To_garden_Monocular,
 		Convert_garden_Monocular_To_v1beta1_Monocular,
 		Convert_v1beta1_NginxIngress_To_garden_NginxIngress,
@@ -165,12 +198,18 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_OpenStackRouter_To_v1beta1_OpenStackRouter,
 		Convert_v1beta1_OpenStackWorker_To_garden_OpenStackWorker,
 		Convert_garden_OpenStackWorker_To_v1beta1_OpenStackWorker,
+		Convert_v1beta1_PagerDutyReceiver_To_garden_PagerDutyReceiver,
+		Convert_garden_PagerDutyReceiver_To_v1beta1_PagerDutyReceiver,
 		Convert_v1beta1_Quota_To_garden_Quota,
 		Convert_garden_Quota_To_v1beta1_Quota,
 		Convert_v1beta1_QuotaList_To_garden_QuotaList,
 		Convert_garden_QuotaList_To_v1beta1_QuotaList,
+		Convert_v1beta1_QuotaShootUsage_To_garden_QuotaShootUsage,
+		Convert_garden_QuotaShootUsage_To_v1beta1_QuotaShootUsage,
 		Convert_v1beta1_QuotaSpec_To_garden_QuotaSpec,
 		Convert_garden_QuotaSpec_To_v1beta1_QuotaSpec,
+		Convert_v1beta1_QuotaStatus_To_garden_QuotaStatus,
+		Convert_garden_QuotaStatus_To_v1beta1_QuotaStatus,
 		Convert_v1beta1_SecretBinding_To_garden_SecretBinding,
 		Convert_garden_SecretBinding_To_v1beta1_SecretBinding,
 		Convert_v1beta1_SecretBindingList_To_garden_SecretBindingList,
@@ -195,6 +234,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_ShootSpec_To_v1beta1_ShootSpec,
 		Convert_v1beta1_ShootStatus_To_garden_ShootStatus,
 		Convert_garden_ShootStatus_To_v1beta1_ShootStatus,
+		Convert_v1beta1_SlackReceiver_To_garden_SlackReceiver,
+		Convert_garden_SlackReceiver_To_v1beta1_SlackReceiver,
 		Convert_v1beta1_VagrantConstraints_To_garden_VagrantConstraints,
 		Convert_garden_VagrantConstraints_To_v1beta1_VagrantConstraints,
 		Convert_v1beta1_VagrantLocal_To_garden_VagrantLocal,
@@ -205,6 +246,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_VagrantProfile_To_v1beta1_VagrantProfile,
 		Convert_v1beta1_VolumeType_To_garden_VolumeType,
 		Convert_garden_VolumeType_To_v1beta1_VolumeType,
+		Convert_v1beta1_WebhookReceiver_To_garden_WebhookReceiver,
+		Convert_garden_WebhookReceiver_To_v1beta1_WebhookReceiver,
 		Convert_v1beta1_Worker_To_garden_Worker,
 		Convert_garden_Worker_To_v1beta1_Worker,
 		Convert_v1beta1_Zone_To_garden_Zone,
@@ -430,6 +473,7 @@ func autoConvert_v1beta1_AWSWorker_To_garden_AWSWorker(in *AWSWorker, out *garde
 	}
 	out.VolumeType = in.VolumeType
 	out.VolumeSize = in.VolumeSize
+	out.MachineImage = (*garden.AWSMachineImage)(unsafe.Pointer(in.MachineImage))
 	return nil
 }
 
@@ -444,6 +488,7 @@ func autoConvert_garden_AWSWorker_To_v1beta1_AWSWorker(in *garden.AWSWorker, out
 	}
 	out.VolumeType = in.VolumeType
 	out.VolumeSize = in.VolumeSize
+	out.MachineImage = (*AWSMachineImage)(unsafe.Pointer(in.MachineImage))
 	return nil
 }
 
@@ -504,6 +549,166 @@ func Convert_garden_Addons_To_v1beta1_Addons(in *garden.Addons, out *Addons, s c
 	return autoConvert_garden_Addons_To_v1beta1_Addons(in, out, s)
 }
 
+func autoConvert_v1beta1_AdmissionPlugin_To_garden_AdmissionPlugin(in *AdmissionPlugin, out *garden.AdmissionPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Config = (*string)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_v1beta1_AdmissionPlugin_To_garden_AdmissionPlugin is an autogenerated conversion function.
+func Convert_v1beta1_AdmissionPlugin_To_garden_AdmissionPlugin(in *AdmissionPlugin, out *garden.AdmissionPlugin, s conversion.Scope) error {
+	return autoConvert_v1beta1_AdmissionPlugin_To_garden_AdmissionPlugin(in, out, s)
+}
+
+func autoConvert_garden_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *garden.AdmissionPlugin, out *AdmissionPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Config = (*string)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_garden_AdmissionPlugin_To_v1beta1_AdmissionPlugin is an autogenerated conversion function.
+func Convert_garden_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *garden.AdmissionPlugin, out *AdmissionPlugin, s conversion.Scope) error {
+	return autoConvert_garden_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in, out, s)
+}
+
+func autoConvert_v1beta1_AlertReceiver_To_garden_AlertReceiver(in *AlertReceiver, out *garden.AlertReceiver, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.Email = (*garden.EmailReceiver)(unsafe.Pointer(in.Email))
+	out.Slack = (*garden.SlackReceiver)(unsafe.Pointer(in.Slack))
+	out.PagerDuty = (*garden.PagerDutyReceiver)(unsafe.Pointer(in.PagerDuty))
+	out.Webhook = (*garden.WebhookReceiver)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_v1beta1_AlertReceiver_To_garden_AlertReceiver is an autogenerated conversion function.
+func Convert_v1beta1_AlertReceiver_To_garden_AlertReceiver(in *AlertReceiver, out *garden.AlertReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlertReceiver_To_garden_AlertReceiver(in, out, s)
+}
+
+func autoConvert_garden_AlertReceiver_To_v1beta1_AlertReceiver(in *garden.AlertReceiver, out *AlertReceiver, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.Email = (*EmailReceiver)(unsafe.Pointer(in.Email))
+	out.Slack = (*SlackReceiver)(unsafe.Pointer(in.Slack))
+	out.PagerDuty = (*PagerDutyReceiver)(unsafe.Pointer(in.PagerDuty))
+	out.Webhook = (*WebhookReceiver)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_garden_AlertReceiver_To_v1beta1_AlertReceiver is an autogenerated conversion function.
+func Convert_garden_AlertReceiver_To_v1beta1_AlertReceiver(in *garden.AlertReceiver, out *AlertReceiver, s conversion.Scope) error {
+	return autoConvert_garden_AlertReceiver_To_v1beta1_AlertReceiver(in, out, s)
+}
+
+func autoConvert_v1beta1_Alerting_To_garden_Alerting(in *Alerting, out *garden.Alerting, s conversion.Scope) error {
+	out.Receivers = *(*[]garden.AlertReceiver)(unsafe.Pointer(&in.Receivers))
+	return nil
+}
+
+// Convert_v1beta1_Alerting_To_garden_Alerting is an autogenerated conversion function.
+func Convert_v1beta1_Alerting_To_garden_Alerting(in *Alerting, out *garden.Alerting, s conversion.Scope) error {
+	return autoConvert_v1beta1_Alerting_To_garden_Alerting(in, out, s)
+}
+
+func autoConvert_garden_Alerting_To_v1beta1_Alerting(in *garden.Alerting, out *Alerting, s conversion.Scope) error {
+	out.Receivers = *(*[]AlertReceiver)(unsafe.Pointer(&in.Receivers))
+	return nil
+}
+
+// Convert_garden_Alerting_To_v1beta1_Alerting is an autogenerated conversion function.
+func Convert_garden_Alerting_To_v1beta1_Alerting(in *garden.Alerting, out *Alerting, s conversion.Scope) error {
+	return autoConvert_garden_Alerting_To_v1beta1_Alerting(in, out, s)
+}
+
+func autoConvert_v1beta1_AuditConfig_To_garden_AuditConfig(in *AuditConfig, out *garden.AuditConfig, s conversion.Scope) error {
+	out.AuditPolicy = (*garden.AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
+	out.Log = (*garden.AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*garden.AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_v1beta1_AuditConfig_To_garden_AuditConfig is an autogenerated conversion function.
+func Convert_v1beta1_AuditConfig_To_garden_AuditConfig(in *AuditConfig, out *garden.AuditConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditConfig_To_garden_AuditConfig(in, out, s)
+}
+
+func autoConvert_garden_AuditConfig_To_v1beta1_AuditConfig(in *garden.AuditConfig, out *AuditConfig, s conversion.Scope) error {
+	out.AuditPolicy = (*AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
+	out.Log = (*AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_garden_AuditConfig_To_v1beta1_AuditConfig is an autogenerated conversion function.
+func Convert_garden_AuditConfig_To_v1beta1_AuditConfig(in *garden.AuditConfig, out *AuditConfig, s conversion.Scope) error {
+	return autoConvert_garden_AuditConfig_To_v1beta1_AuditConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_AuditLogBackend_To_garden_AuditLogBackend(in *AuditLogBackend, out *garden.AuditLogBackend, s conversion.Scope) error {
+	out.Format = (*string)(unsafe.Pointer(in.Format))
+	return nil
+}
+
+// Convert_v1beta1_AuditLogBackend_To_garden_AuditLogBackend is an autogenerated conversion function.
+func Convert_v1beta1_AuditLogBackend_To_garden_AuditLogBackend(in *AuditLogBackend, out *garden.AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditLogBackend_To_garden_AuditLogBackend(in, out, s)
+}
+
+func autoConvert_garden_AuditLogBackend_To_v1beta1_AuditLogBackend(in *garden.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	out.Format = (*string)(unsafe.Pointer(in.Format))
+	return nil
+}
+
+// Convert_garden_AuditLogBackend_To_v1beta1_AuditLogBackend is an autogenerated conversion function.
+func Convert_garden_AuditLogBackend_To_v1beta1_AuditLogBackend(in *garden.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_garden_AuditLogBackend_To_v1beta1_AuditLogBackend(in, out, s)
+}
+
+func autoConvert_v1beta1_AuditPolicy_To_garden_AuditPolicy(in *AuditPolicy, out *garden.AuditPolicy, s conversion.Scope) error {
+	out.ConfigMapRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	return nil
+}
+
+// Convert_v1beta1_AuditPolicy_To_garden_AuditPolicy is an autogenerated conversion function.
+func Convert_v1beta1_AuditPolicy_To_garden_AuditPolicy(in *AuditPolicy, out *garden.AuditPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditPolicy_To_garden_AuditPolicy(in, out, s)
+}
+
+func autoConvert_garden_AuditPolicy_To_v1beta1_AuditPolicy(in *garden.AuditPolicy, out *AuditPolicy, s conversion.Scope) error {
+	out.ConfigMapRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	return nil
+}
+
+// Convert_garden_AuditPolicy_To_v1beta1_AuditPolicy is an autogenerated conversion function.
+func Convert_garden_AuditPolicy_To_v1beta1_AuditPolicy(in *garden.AuditPolicy, out *AuditPolicy, s conversion.Scope) error {
+	return autoConvert_garden_AuditPolicy_To_v1beta1_AuditPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_AuditWebhookBackend_To_garden_AuditWebhookBackend(in *AuditWebhookBackend, out *garden.AuditWebhookBackend, s conversion.Scope) error {
+	out.KubeconfigSecretRef = in.KubeconfigSecretRef
+	out.Mode = (*string)(unsafe.Pointer(in.Mode))
+	return nil
+}
+
+// Convert_v1beta1_AuditWebhookBackend_To_garden_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_v1beta1_AuditWebhookBackend_To_garden_AuditWebhookBackend(in *AuditWebhookBackend, out *garden.AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditWebhookBackend_To_garden_AuditWebhookBackend(in, out, s)
+}
+
+func autoConvert_garden_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in *garden.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	out.KubeconfigSecretRef = in.KubeconfigSecretRef
+	out.Mode = (*string)(unsafe.Pointer(in.Mode))
+	return nil
+}
+
+// Convert_garden_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_garden_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in *garden.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_garden_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in, out, s)
+}
+
 func autoConvert_v1beta1_AzureCloud_To_garden_AzureCloud(in *AzureCloud, out *garden.AzureCloud, s conversion.Scope) error {
 	out.MachineImage = (*garden.AzureMachineImage)(unsafe.Pointer(in.MachineImage))
 	if err := Convert_v1beta1_AzureNetworks_To_garden_AzureNetworks(&in.Networks, &out.Networks, s); err != nil {
@@ -724,6 +929,7 @@ func autoConvert_v1beta1_AzureWorker_To_garden_AzureWorker(in *AzureWorker, out
 	}
 	out.VolumeType = in.VolumeType
 	out.VolumeSize = in.VolumeSize
+	out.MachineImage = (*garden.AzureMachineImage)(unsafe.Pointer(in.MachineImage))
 	return nil
 }
 
@@ -738,6 +944,7 @@ func autoConvert_garden_AzureWorker_To_v1beta1_AzureWorker(in *garden.AzureWorke
 	}
 	out.VolumeType = in.VolumeType
 	out.VolumeSize = in.VolumeSize
+	out.MachineImage = (*AzureMachineImage)(unsafe.Pointer(in.MachineImage))
 	return nil
 }
 
@@ -768,6 +975,28 @@ func Convert_garden_Backup_To_v1beta1_Backup(in *garden.Backup, out *Backup, s c
 	return autoConvert_garden_Backup_To_v1beta1_Backup(in, out, s)
 }
 
+func autoConvert_v1beta1_Certificate_To_garden_Certificate(in *Certificate, out *garden.Certificate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NotAfter = in.NotAfter
+	return nil
+}
+
+// Convert_v1beta1_Certificate_To_garden_Certificate is an autogenerated conversion function.
+func Convert_v1beta1_Certificate_To_garden_Certificate(in *Certificate, out *garden.Certificate, s conversion.Scope) error {
+	return autoConvert_v1beta1_Certificate_To_garden_Certificate(in, out, s)
+}
+
+func autoConvert_garden_Certificate_To_v1beta1_Certificate(in *garden.Certificate, out *Certificate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NotAfter = in.NotAfter
+	return nil
+}
+
+// Convert_garden_Certificate_To_v1beta1_Certificate is an autogenerated conversion function.
+func Convert_garden_Certificate_To_v1beta1_Certificate(in *garden.Certificate, out *Certificate, s conversion.Scope) error {
+	return autoConvert_garden_Certificate_To_v1beta1_Certificate(in, out, s)
+}
+
 func autoConvert_v1beta1_Cloud_To_garden_Cloud(in *Cloud, out *garden.Cloud, s conversion.Scope) error {
 	out.Profile = in.Profile
 	out.Region = in.Region
@@ -778,6 +1007,7 @@ func autoConvert_v1beta1_Cloud_To_garden_Cloud(in *Cloud, out *garden.Cloud, s c
 	out.GCP = (*garden.GCPCloud)(unsafe.Pointer(in.GCP))
 	out.OpenStack = (*garden.OpenStackCloud)(unsafe.Pointer(in.OpenStack))
 	out.Vagrant = (*garden.VagrantLocal)(unsafe.Pointer(in.Vagrant))
+	out.SequentialWorkerRollout = (*bool)(unsafe.Pointer(in.SequentialWorkerRollout))
 	return nil
 }
 
@@ -796,6 +1026,7 @@ func autoConvert_garden_Cloud_To_v1beta1_Cloud(in *garden.Cloud, out *Cloud, s c
 	out.GCP = (*GCPCloud)(unsafe.Pointer(in.GCP))
 	out.OpenStack = (*OpenStackCloud)(unsafe.Pointer(in.OpenStack))
 	out.Vagrant = (*VagrantLocal)(unsafe.Pointer(in.Vagrant))
+	out.SequentialWorkerRollout = (*bool)(unsafe.Pointer(in.SequentialWorkerRollout))
 	return nil
 }
 
@@ -980,6 +1211,26 @@ func Convert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in *g
 	return autoConvert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in, out, s)
 }
 
+func autoConvert_v1beta1_EmailReceiver_To_garden_EmailReceiver(in *EmailReceiver, out *garden.EmailReceiver, s conversion.Scope) error {
+	out.To = *(*[]string)(unsafe.Pointer(&in.To))
+	return nil
+}
+
+// Convert_v1beta1_EmailReceiver_To_garden_EmailReceiver is an autogenerated conversion function.
+func Convert_v1beta1_EmailReceiver_To_garden_EmailReceiver(in *EmailReceiver, out *garden.EmailReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_EmailReceiver_To_garden_EmailReceiver(in, out, s)
+}
+
+func autoConvert_garden_EmailReceiver_To_v1beta1_EmailReceiver(in *garden.EmailReceiver, out *EmailReceiver, s conversion.Scope) error {
+	out.To = *(*[]string)(unsafe.Pointer(&in.To))
+	return nil
+}
+
+// Convert_garden_EmailReceiver_To_v1beta1_EmailReceiver is an autogenerated conversion function.
+func Convert_garden_EmailReceiver_To_v1beta1_EmailReceiver(in *garden.EmailReceiver, out *EmailReceiver, s conversion.Scope) error {
+	return autoConvert_garden_EmailReceiver_To_v1beta1_EmailReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_GCPCloud_To_garden_GCPCloud(in *GCPCloud, out *garden.GCPCloud, s conversion.Scope) error {
 	out.MachineImage = (*garden.GCPMachineImage)(unsafe.Pointer(in.MachineImage))
 	if err := Convert_v1beta1_GCPNetworks_To_garden_GCPNetworks(&in.Networks, &out.Networks, s); err != nil {
@@ -1017,6 +1268,7 @@ func autoConvert_v1beta1_GCPConstraints_To_garden_GCPConstraints(in *GCPConstrai
 	}
 	out.MachineImages = *(*[]garden.GCPMachineImage)(unsafe.Pointer(&in.MachineImages))
 	out.MachineTypes = *(*[]garden.MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.PreemptibleMachineTypes = *(*[]string)(unsafe.Pointer(&in.PreemptibleMachineTypes))
 	out.VolumeTypes = *(*[]garden.VolumeType)(unsafe.Pointer(&in.VolumeTypes))
 	out.Zones = *(*[]garden.Zone)(unsafe.Pointer(&in.Zones))
 	return nil
@@ -1034,6 +1286,7 @@ func autoConvert_garden_GCPConstraints_To_v1beta1_GCPConstraints(in *garden.GCPC
 	}
 	out.MachineImages = *(*[]GCPMachineImage)(unsafe.Pointer(&in.MachineImages))
 	out.MachineTypes = *(*[]MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.PreemptibleMachineTypes = *(*[]string)(unsafe.Pointer(&in.PreemptibleMachineTypes))
 	out.VolumeTypes = *(*[]VolumeType)(unsafe.Pointer(&in.VolumeTypes))
 	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
 	return nil
@@ -1144,6 +1397,8 @@ func autoConvert_v1beta1_GCPWorker_To_garden_GCPWorker(in *GCPWorker, out *garde
 	}
 	out.VolumeType = in.VolumeType
 	out.VolumeSize = in.VolumeSize
+	out.MachineImage = (*garden.GCPMachineImage)(unsafe.Pointer(in.MachineImage))
+	out.Preemptible = (*bool)(unsafe.Pointer(in.Preemptible))
 	return nil
 }
 
@@ -1158,6 +1413,8 @@ func autoConvert_garden_GCPWorker_To_v1beta1_GCPWorker(in *garden.GCPWorker, out
 	}
 	out.VolumeType = in.VolumeType
 	out.VolumeSize = in.VolumeSize
+	out.MachineImage = (*GCPMachineImage)(unsafe.Pointer(in.MachineImage))
+	out.Preemptible = (*bool)(unsafe.Pointer(in.Preemptible))
 	return nil
 }
 
@@ -1238,6 +1495,76 @@ func Convert_garden_HelmTiller_To_v1beta1_HelmTiller(in *garden.HelmTiller, out
 	return autoConvert_garden_HelmTiller_To_v1beta1_HelmTiller(in, out, s)
 }
 
+func autoConvert_v1beta1_Hibernation_To_garden_Hibernation(in *Hibernation, out *garden.Hibernation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Schedules = *(*[]garden.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	return nil
+}
+
+// Convert_v1beta1_Hibernation_To_garden_Hibernation is an autogenerated conversion function.
+func Convert_v1beta1_Hibernation_To_garden_Hibernation(in *Hibernation, out *garden.Hibernation, s conversion.Scope) error {
+	return autoConvert_v1beta1_Hibernation_To_garden_Hibernation(in, out, s)
+}
+
+func autoConvert_garden_Hibernation_To_v1beta1_Hibernation(in *garden.Hibernation, out *Hibernation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	return nil
+}
+
+// Convert_garden_Hibernation_To_v1beta1_Hibernation is an autogenerated conversion function.
+func Convert_garden_Hibernation_To_v1beta1_Hibernation(in *garden.Hibernation, out *Hibernation, s conversion.Scope) error {
+	return autoConvert_garden_Hibernation_To_v1beta1_Hibernation(in, out, s)
+}
+
+func autoConvert_v1beta1_HibernationSchedule_To_garden_HibernationSchedule(in *HibernationSchedule, out *garden.HibernationSchedule, s conversion.Scope) error {
+	out.Start = (*string)(unsafe.Pointer(in.Start))
+	out.End = (*string)(unsafe.Pointer(in.End))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	return nil
+}
+
+// Convert_v1beta1_HibernationSchedule_To_garden_HibernationSchedule is an autogenerated conversion function.
+func Convert_v1beta1_HibernationSchedule_To_garden_HibernationSchedule(in *HibernationSchedule, out *garden.HibernationSchedule, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationSchedule_To_garden_HibernationSchedule(in, out, s)
+}
+
+func autoConvert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule(in *garden.HibernationSchedule, out *HibernationSchedule, s conversion.Scope) error {
+	out.Start = (*string)(unsafe.Pointer(in.Start))
+	out.End = (*string)(unsafe.Pointer(in.End))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	return nil
+}
+
+// Convert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule is an autogenerated conversion function.
+func Convert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule(in *garden.HibernationSchedule, out *HibernationSchedule, s conversion.Scope) error {
+	return autoConvert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule(in, out, s)
+}
+
+func autoConvert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(in *HorizontalPodAutoscalerConfig, out *garden.HorizontalPodAutoscalerConfig, s conversion.Scope) error {
+	out.SyncPeriod = (*meta_v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.DownscaleDelay = (*meta_v1.Duration)(unsafe.Pointer(in.DownscaleDelay))
+	out.UpscaleDelay = (*meta_v1.Duration)(unsafe.Pointer(in.UpscaleDelay))
+	return nil
+}
+
+// Convert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig is an autogenerated conversion function.
+func Convert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(in *HorizontalPodAutoscalerConfig, out *garden.HorizontalPodAutoscalerConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(in, out, s)
+}
+
+func autoConvert_garden_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscalerConfig(in *garden.HorizontalPodAutoscalerConfig, out *HorizontalPodAutoscalerConfig, s conversion.Scope) error {
+	out.SyncPeriod = (*meta_v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.DownscaleDelay = (*meta_v1.Duration)(unsafe.Pointer(in.DownscaleDelay))
+	out.UpscaleDelay = (*meta_v1.Duration)(unsafe.Pointer(in.UpscaleDelay))
+	return nil
+}
+
+// Convert_garden_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscalerConfig is an autogenerated conversion function.
+func Convert_garden_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscalerConfig(in *garden.HorizontalPodAutoscalerConfig, out *HorizontalPodAutoscalerConfig, s conversion.Scope) error {
+	return autoConvert_garden_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscalerConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_K8SNetworks_To_garden_K8SNetworks(in *K8SNetworks, out *garden.K8SNetworks, s conversion.Scope) error {
 	out.Nodes = (*garden.CIDR)(unsafe.Pointer(in.Nodes))
 	out.Pods = (*garden.CIDR)(unsafe.Pointer(in.Pods))
@@ -1318,6 +1645,8 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_garden_KubeAPIServerConfig(in *K
 	}
 	out.RuntimeConfig = *(*map[string]bool)(unsafe.Pointer(&in.RuntimeConfig))
 	out.OIDCConfig = (*garden.OIDCConfig)(unsafe.Pointer(in.OIDCConfig))
+	out.AuditConfig = (*garden.AuditConfig)(unsafe.Pointer(in.AuditConfig))
+	out.AdmissionPlugins = *(*[]garden.AdmissionPlugin)(unsafe.Pointer(&in.AdmissionPlugins))
 	return nil
 }
 
@@ -1332,6 +1661,8 @@ func autoConvert_garden_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *g
 	}
 	out.RuntimeConfig = *(*map[string]bool)(unsafe.Pointer(&in.RuntimeConfig))
 	out.OIDCConfig = (*OIDCConfig)(unsafe.Pointer(in.OIDCConfig))
+	out.AuditConfig = (*AuditConfig)(unsafe.Pointer(in.AuditConfig))
+	out.AdmissionPlugins = *(*[]AdmissionPlugin)(unsafe.Pointer(&in.AdmissionPlugins))
 	return nil
 }
 
@@ -1344,6 +1675,8 @@ func autoConvert_v1beta1_KubeControllerManagerConfig_To_garden_KubeControllerMan
 	if err := Convert_v1beta1_KubernetesConfig_To_garden_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
+	out.HorizontalPodAutoscalerConfig = (*garden.HorizontalPodAutoscalerConfig)(unsafe.Pointer(in.HorizontalPodAutoscalerConfig))
+	out.NodeMonitorGracePeriod = (*meta_v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
 	return nil
 }
 
@@ -1356,6 +1689,8 @@ func autoConvert_garden_KubeControllerManagerConfig_To_v1beta1_KubeControllerMan
 	if err := Convert_garden_KubernetesConfig_To_v1beta1_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
+	out.HorizontalPodAutoscalerConfig = (*HorizontalPodAutoscalerConfig)(unsafe.Pointer(in.HorizontalPodAutoscalerConfig))
+	out.NodeMonitorGracePeriod = (*meta_v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
 	return nil
 }
 
@@ -1394,6 +1729,7 @@ func autoConvert_v1beta1_KubeProxyConfig_To_garden_KubeProxyConfig(in *KubeProxy
 	if err := Convert_v1beta1_KubernetesConfig_To_garden_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
+	out.Mode = (*garden.ProxyMode)(unsafe.Pointer(in.Mode))
 	return nil
 }
 
@@ -1406,6 +1742,7 @@ func autoConvert_garden_KubeProxyConfig_To_v1beta1_KubeProxyConfig(in *garden.Ku
 	if err := Convert_garden_KubernetesConfig_To_v1beta1_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
+	out.Mode = (*ProxyMode)(unsafe.Pointer(in.Mode))
 	return nil
 }
 
@@ -1442,6 +1779,13 @@ func autoConvert_v1beta1_KubeletConfig_To_garden_KubeletConfig(in *KubeletConfig
 	if err := Convert_v1beta1_KubernetesConfig_To_garden_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
+	out.CPUManagerPolicy = (*string)(unsafe.Pointer(in.CPUManagerPolicy))
+	out.EvictionHard = (*garden.KubeletConfigEviction)(unsafe.Pointer(in.EvictionHard))
+	out.EvictionSoft = (*garden.KubeletConfigEviction)(unsafe.Pointer(in.EvictionSoft))
+	out.EvictionSoftGracePeriod = (*garden.KubeletConfigEvictionSoftGracePeriod)(unsafe.Pointer(in.EvictionSoftGracePeriod))
+	out.EvictionPressureTransitionPeriod = (*meta_v1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
+	out.EvictionMaxPodGracePeriod = (*int32)(unsafe.Pointer(in.EvictionMaxPodGracePeriod))
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
 	return nil
 }
 
@@ -1454,6 +1798,13 @@ func autoConvert_garden_KubeletConfig_To_v1beta1_KubeletConfig(in *garden.Kubele
 	if err := Convert_garden_KubernetesConfig_To_v1beta1_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
 	}
+	out.CPUManagerPolicy = (*string)(unsafe.Pointer(in.CPUManagerPolicy))
+	out.EvictionHard = (*KubeletConfigEviction)(unsafe.Pointer(in.EvictionHard))
+	out.EvictionSoft = (*KubeletConfigEviction)(unsafe.Pointer(in.EvictionSoft))
+	out.EvictionSoftGracePeriod = (*KubeletConfigEvictionSoftGracePeriod)(unsafe.Pointer(in.EvictionSoftGracePeriod))
+	out.EvictionPressureTransitionPeriod = (*meta_v1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
+	out.EvictionMaxPodGracePeriod = (*int32)(unsafe.Pointer(in.EvictionMaxPodGracePeriod))
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
 	return nil
 }
 
@@ -1462,6 +1813,62 @@ func Convert_garden_KubeletConfig_To_v1beta1_KubeletConfig(in *garden.KubeletCon
 	return autoConvert_garden_KubeletConfig_To_v1beta1_KubeletConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeletConfigEviction_To_garden_KubeletConfigEviction(in *KubeletConfigEviction, out *garden.KubeletConfigEviction, s conversion.Scope) error {
+	out.MemoryAvailable = (*string)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*string)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*string)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*string)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*string)(unsafe.Pointer(in.NodeFSInodesFree))
+	return nil
+}
+
+// Convert_v1beta1_KubeletConfigEviction_To_garden_KubeletConfigEviction is an autogenerated conversion function.
+func Convert_v1beta1_KubeletConfigEviction_To_garden_KubeletConfigEviction(in *KubeletConfigEviction, out *garden.KubeletConfigEviction, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeletConfigEviction_To_garden_KubeletConfigEviction(in, out, s)
+}
+
+func autoConvert_garden_KubeletConfigEviction_To_v1beta1_KubeletConfigEviction(in *garden.KubeletConfigEviction, out *KubeletConfigEviction, s conversion.Scope) error {
+	out.MemoryAvailable = (*string)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*string)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*string)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*string)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*string)(unsafe.Pointer(in.NodeFSInodesFree))
+	return nil
+}
+
+// Convert_garden_KubeletConfigEviction_To_v1beta1_KubeletConfigEviction is an autogenerated conversion function.
+func Convert_garden_KubeletConfigEviction_To_v1beta1_KubeletConfigEviction(in *garden.KubeletConfigEviction, out *KubeletConfigEviction, s conversion.Scope) error {
+	return autoConvert_garden_KubeletConfigEviction_To_v1beta1_KubeletConfigEviction(in, out, s)
+}
+
+func autoConvert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_garden_KubeletConfigEvictionSoftGracePeriod(in *KubeletConfigEvictionSoftGracePeriod, out *garden.KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
+	out.MemoryAvailable = (*meta_v1.Duration)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*meta_v1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*meta_v1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*meta_v1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*meta_v1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
+	return nil
+}
+
+// Convert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_garden_KubeletConfigEvictionSoftGracePeriod is an autogenerated conversion function.
+func Convert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_garden_KubeletConfigEvictionSoftGracePeriod(in *KubeletConfigEvictionSoftGracePeriod, out *garden.KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_garden_KubeletConfigEvictionSoftGracePeriod(in, out, s)
+}
+
+func autoConvert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1beta1_KubeletConfigEvictionSoftGracePeriod(in *garden.KubeletConfigEvictionSoftGracePeriod, out *KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
+	out.MemoryAvailable = (*meta_v1.Duration)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*meta_v1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*meta_v1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*meta_v1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*meta_v1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
+	return nil
+}
+
+// Convert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1beta1_KubeletConfigEvictionSoftGracePeriod is an autogenerated conversion function.
+func Convert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1beta1_KubeletConfigEvictionSoftGracePeriod(in *garden.KubeletConfigEvictionSoftGracePeriod, out *KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
+	return autoConvert_garden_KubeletConfigEvictionSoftGracePeriod_To_v1beta1_KubeletConfigEvictionSoftGracePeriod(in, out, s)
+}
+
 func autoConvert_v1beta1_Kubernetes_To_garden_Kubernetes(in *Kubernetes, out *garden.Kubernetes, s conversion.Scope) error {
 	out.AllowPrivilegedContainers = (*bool)(unsafe.Pointer(in.AllowPrivilegedContainers))
 	out.KubeAPIServer = (*garden.KubeAPIServerConfig)(unsafe.Pointer(in.KubeAPIServer))
@@ -1698,6 +2105,48 @@ func Convert_garden_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *g
 	return autoConvert_garden_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in, out, s)
 }
 
+func autoConvert_v1beta1_Monitoring_To_garden_Monitoring(in *Monitoring, out *garden.Monitoring, s conversion.Scope) error {
+	out.Alerting = (*garden.Alerting)(unsafe.Pointer(in.Alerting))
+	out.Rules = (*garden.MonitoringRules)(unsafe.Pointer(in.Rules))
+	return nil
+}
+
+// Convert_v1beta1_Monitoring_To_garden_Monitoring is an autogenerated conversion function.
+func Convert_v1beta1_Monitoring_To_garden_Monitoring(in *Monitoring, out *garden.Monitoring, s conversion.Scope) error {
+	return autoConvert_v1beta1_Monitoring_To_garden_Monitoring(in, out, s)
+}
+
+func autoConvert_garden_Monitoring_To_v1beta1_Monitoring(in *garden.Monitoring, out *Monitoring, s conversion.Scope) error {
+	out.Alerting = (*Alerting)(unsafe.Pointer(in.Alerting))
+	out.Rules = (*MonitoringRules)(unsafe.Pointer(in.Rules))
+	return nil
+}
+
+// Convert_garden_Monitoring_To_v1beta1_Monitoring is an autogenerated conversion function.
+func Convert_garden_Monitoring_To_v1beta1_Monitoring(in *garden.Monitoring, out *Monitoring, s conversion.Scope) error {
+	return autoConvert_garden_Monitoring_To_v1beta1_Monitoring(in, out, s)
+}
+
+func autoConvert_v1beta1_MonitoringRules_To_garden_MonitoringRules(in *MonitoringRules, out *garden.MonitoringRules, s conversion.Scope) error {
+	out.ConfigMapRef = in.ConfigMapRef
+	return nil
+}
+
+// Convert_v1beta1_MonitoringRules_To_garden_MonitoringRules is an autogenerated conversion function.
+func Convert_v1beta1_MonitoringRules_To_garden_MonitoringRules(in *MonitoringRules, out *garden.MonitoringRules, s conversion.Scope) error {
+	return autoConvert_v1beta1_MonitoringRules_To_garden_MonitoringRules(in, out, s)
+}
+
+func autoConvert_garden_MonitoringRules_To_v1beta1_MonitoringRules(in *garden.MonitoringRules, out *MonitoringRules, s conversion.Scope) error {
+	out.ConfigMapRef = in.ConfigMapRef
+	return nil
+}
+
+// Convert_garden_MonitoringRules_To_v1beta1_MonitoringRules is an autogenerated conversion function.
+func Convert_garden_MonitoringRules_To_v1beta1_MonitoringRules(in *garden.MonitoringRules, out *MonitoringRules, s conversion.Scope) error {
+	return autoConvert_garden_MonitoringRules_To_v1beta1_MonitoringRules(in, out, s)
+}
+
 func autoConvert_v1beta1_Monocular_To_garden_Monocular(in *Monocular, out *garden.Monocular, s conversion.Scope) error {
 	if err := Convert_v1beta1_Addon_To_garden_Addon(&in.Addon, &out.Addon, s); err != nil {
 		return err
@@ -2018,6 +2467,7 @@ func autoConvert_v1beta1_OpenStackWorker_To_garden_OpenStackWorker(in *OpenStack
 	if err := Convert_v1beta1_Worker_To_garden_Worker(&in.Worker, &out.Worker, s); err != nil {
 		return err
 	}
+	out.MachineImage = (*garden.OpenStackMachineImage)(unsafe.Pointer(in.MachineImage))
 	return nil
 }
 
@@ -2030,6 +2480,7 @@ func autoConvert_garden_OpenStackWorker_To_v1beta1_OpenStackWorker(in *garden.Op
 	if err := Convert_garden_Worker_To_v1beta1_Worker(&in.Worker, &out.Worker, s); err != nil {
 		return err
 	}
+	out.MachineImage = (*OpenStackMachineImage)(unsafe.Pointer(in.MachineImage))
 	return nil
 }
 
@@ -2038,11 +2489,34 @@ func Convert_garden_OpenStackWorker_To_v1beta1_OpenStackWorker(in *garden.OpenSt
 	return autoConvert_garden_OpenStackWorker_To_v1beta1_OpenStackWorker(in, out, s)
 }
 
+func autoConvert_v1beta1_PagerDutyReceiver_To_garden_PagerDutyReceiver(in *PagerDutyReceiver, out *garden.PagerDutyReceiver, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1beta1_PagerDutyReceiver_To_garden_PagerDutyReceiver is an autogenerated conversion function.
+func Convert_v1beta1_PagerDutyReceiver_To_garden_PagerDutyReceiver(in *PagerDutyReceiver, out *garden.PagerDutyReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_PagerDutyReceiver_To_garden_PagerDutyReceiver(in, out, s)
+}
+
+func autoConvert_garden_PagerDutyReceiver_To_v1beta1_PagerDutyReceiver(in *garden.PagerDutyReceiver, out *PagerDutyReceiver, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_garden_PagerDutyReceiver_To_v1beta1_PagerDutyReceiver is an autogenerated conversion function.
+func Convert_garden_PagerDutyReceiver_To_v1beta1_PagerDutyReceiver(in *garden.PagerDutyReceiver, out *PagerDutyReceiver, s conversion.Scope) error {
+	return autoConvert_garden_PagerDutyReceiver_To_v1beta1_PagerDutyReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_Quota_To_garden_Quota(in *Quota, out *garden.Quota, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_QuotaSpec_To_garden_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_QuotaStatus_To_garden_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2056,6 +2530,9 @@ func autoConvert_garden_Quota_To_v1beta1_Quota(in *garden.Quota, out *Quota, s c
 	if err := Convert_garden_QuotaSpec_To_v1beta1_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_garden_QuotaStatus_To_v1beta1_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2086,6 +2563,30 @@ func Convert_garden_QuotaList_To_v1beta1_QuotaList(in *garden.QuotaList, out *Qu
 	return autoConvert_garden_QuotaList_To_v1beta1_QuotaList(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaShootUsage_To_garden_QuotaShootUsage(in *QuotaShootUsage, out *garden.QuotaShootUsage, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_v1beta1_QuotaShootUsage_To_garden_QuotaShootUsage is an autogenerated conversion function.
+func Convert_v1beta1_QuotaShootUsage_To_garden_QuotaShootUsage(in *QuotaShootUsage, out *garden.QuotaShootUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaShootUsage_To_garden_QuotaShootUsage(in, out, s)
+}
+
+func autoConvert_garden_QuotaShootUsage_To_v1beta1_QuotaShootUsage(in *garden.QuotaShootUsage, out *QuotaShootUsage, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_garden_QuotaShootUsage_To_v1beta1_QuotaShootUsage is an autogenerated conversion function.
+func Convert_garden_QuotaShootUsage_To_v1beta1_QuotaShootUsage(in *garden.QuotaShootUsage, out *QuotaShootUsage, s conversion.Scope) error {
+	return autoConvert_garden_QuotaShootUsage_To_v1beta1_QuotaShootUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_QuotaSpec_To_garden_QuotaSpec(in *QuotaSpec, out *garden.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int)(unsafe.Pointer(in.ClusterLifetimeDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
@@ -2110,6 +2611,28 @@ func Convert_garden_QuotaSpec_To_v1beta1_QuotaSpec(in *garden.QuotaSpec, out *Qu
 	return autoConvert_garden_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaStatus_To_garden_QuotaStatus(in *QuotaStatus, out *garden.QuotaStatus, s conversion.Scope) error {
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Shoots = *(*[]garden.QuotaShootUsage)(unsafe.Pointer(&in.Shoots))
+	return nil
+}
+
+// Convert_v1beta1_QuotaStatus_To_garden_QuotaStatus is an autogenerated conversion function.
+func Convert_v1beta1_QuotaStatus_To_garden_QuotaStatus(in *QuotaStatus, out *garden.QuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaStatus_To_garden_QuotaStatus(in, out, s)
+}
+
+func autoConvert_garden_QuotaStatus_To_v1beta1_QuotaStatus(in *garden.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Shoots = *(*[]QuotaShootUsage)(unsafe.Pointer(&in.Shoots))
+	return nil
+}
+
+// Convert_garden_QuotaStatus_To_v1beta1_QuotaStatus is an autogenerated conversion function.
+func Convert_garden_QuotaStatus_To_v1beta1_QuotaStatus(in *garden.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	return autoConvert_garden_QuotaStatus_To_v1beta1_QuotaStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_SecretBinding_To_garden_SecretBinding(in *SecretBinding, out *garden.SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
@@ -2377,10 +2900,12 @@ func autoConvert_v1beta1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *garde
 	if err := Convert_v1beta1_DNS_To_garden_DNS(&in.DNS, &out.DNS, s); err != nil {
 		return err
 	}
+	out.Hibernation = (*garden.Hibernation)(unsafe.Pointer(in.Hibernation))
 	if err := Convert_v1beta1_Kubernetes_To_garden_Kubernetes(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
 	}
 	out.Maintenance = (*garden.Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Monitoring = (*garden.Monitoring)(unsafe.Pointer(in.Monitoring))
 	return nil
 }
 
@@ -2398,10 +2923,12 @@ func autoConvert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out
 	if err := Convert_garden_DNS_To_v1beta1_DNS(&in.DNS, &out.DNS, s); err != nil {
 		return err
 	}
+	out.Hibernation = (*Hibernation)(unsafe.Pointer(in.Hibernation))
 	if err := Convert_garden_Kubernetes_To_v1beta1_Kubernetes(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
 	}
 	out.Maintenance = (*Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Monitoring = (*Monitoring)(unsafe.Pointer(in.Monitoring))
 	return nil
 }
 
@@ -2420,6 +2947,8 @@ func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out
 	out.RetryCycleStartTime = (*meta_v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.UID = types.UID(in.UID)
+	out.Seed = (*string)(unsafe.Pointer(in.Seed))
+	out.Certificates = *(*[]garden.Certificate)(unsafe.Pointer(&in.Certificates))
 	return nil
 }
 
@@ -2438,6 +2967,8 @@ func autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatu
 	out.RetryCycleStartTime = (*meta_v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.UID = types.UID(in.UID)
+	out.Seed = (*string)(unsafe.Pointer(in.Seed))
+	out.Certificates = *(*[]Certificate)(unsafe.Pointer(&in.Certificates))
 	return nil
 }
 
@@ -2446,6 +2977,28 @@ func Convert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatus, o
 	return autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_SlackReceiver_To_garden_SlackReceiver(in *SlackReceiver, out *garden.SlackReceiver, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.Channel = (*string)(unsafe.Pointer(in.Channel))
+	return nil
+}
+
+// Convert_v1beta1_SlackReceiver_To_garden_SlackReceiver is an autogenerated conversion function.
+func Convert_v1beta1_SlackReceiver_To_garden_SlackReceiver(in *SlackReceiver, out *garden.SlackReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_SlackReceiver_To_garden_SlackReceiver(in, out, s)
+}
+
+func autoConvert_garden_SlackReceiver_To_v1beta1_SlackReceiver(in *garden.SlackReceiver, out *SlackReceiver, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.Channel = (*string)(unsafe.Pointer(in.Channel))
+	return nil
+}
+
+// Convert_garden_SlackReceiver_To_v1beta1_SlackReceiver is an autogenerated conversion function.
+func Convert_garden_SlackReceiver_To_v1beta1_SlackReceiver(in *garden.SlackReceiver, out *SlackReceiver, s conversion.Scope) error {
+	return autoConvert_garden_SlackReceiver_To_v1beta1_SlackReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_VagrantConstraints_To_garden_VagrantConstraints(in *VagrantConstraints, out *garden.VagrantConstraints, s conversion.Scope) error {
 	out.DNSProviders = *(*[]garden.DNSProviderConstraint)(unsafe.Pointer(&in.DNSProviders))
 	return nil
@@ -2562,11 +3115,37 @@ func Convert_garden_VolumeType_To_v1beta1_VolumeType(in *garden.VolumeType, out
 	return autoConvert_garden_VolumeType_To_v1beta1_VolumeType(in, out, s)
 }
 
+func autoConvert_v1beta1_WebhookReceiver_To_garden_WebhookReceiver(in *WebhookReceiver, out *garden.WebhookReceiver, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1beta1_WebhookReceiver_To_garden_WebhookReceiver is an autogenerated conversion function.
+func Convert_v1beta1_WebhookReceiver_To_garden_WebhookReceiver(in *WebhookReceiver, out *garden.WebhookReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_WebhookReceiver_To_garden_WebhookReceiver(in, out, s)
+}
+
+func autoConvert_garden_WebhookReceiver_To_v1beta1_WebhookReceiver(in *garden.WebhookReceiver, out *WebhookReceiver, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_garden_WebhookReceiver_To_v1beta1_WebhookReceiver is an autogenerated conversion function.
+func Convert_garden_WebhookReceiver_To_v1beta1_WebhookReceiver(in *garden.WebhookReceiver, out *WebhookReceiver, s conversion.Scope) error {
+	return autoConvert_garden_WebhookReceiver_To_v1beta1_WebhookReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_Worker_To_garden_Worker(in *Worker, out *garden.Worker, s conversion.Scope) error {
 	out.Name = in.Name
 	out.MachineType = in.MachineType
 	out.AutoScalerMin = in.AutoScalerMin
 	out.AutoScalerMax = in.AutoScalerMax
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.KubeletVersion = (*string)(unsafe.Pointer(in.KubeletVersion))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	return nil
 }
 
@@ -2580,6 +3159,12 @@ func autoConvert_garden_Worker_To_v1beta1_Worker(in *garden.Worker, out *Worker,
 	out.MachineType = in.MachineType
 	out.AutoScalerMin = in.AutoScalerMin
 	out.AutoScalerMax = in.AutoScalerMax
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.KubeletVersion = (*string)(unsafe.Pointer(in.KubeletVersion))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	return nil
 }
 
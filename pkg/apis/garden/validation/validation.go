@@ -19,17 +19,24 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/gardener/gardener/pkg/apis/garden"
 	"github.com/gardener/gardener/pkg/apis/garden/helper"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/ghodss/yaml"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -96,6 +103,7 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 		allErrs = append(allErrs, validateKubernetesConstraints(spec.GCP.Constraints.Kubernetes, fldPath.Child("gcp", "constraints", "kubernetes"))...)
 		allErrs = append(allErrs, validateGCPMachineImages(spec.GCP.Constraints.MachineImages, fldPath.Child("gcp", "constraints", "machineImages"))...)
 		allErrs = append(allErrs, validateMachineTypeConstraints(spec.GCP.Constraints.MachineTypes, fldPath.Child("gcp", "constraints", "machineTypes"))...)
+		allErrs = append(allErrs, validateMachineTypeNameReferences(spec.GCP.Constraints.PreemptibleMachineTypes, spec.GCP.Constraints.MachineTypes, fldPath.Child("gcp", "constraints", "preemptibleMachineTypes"))...)
 		allErrs = append(allErrs, validateVolumeTypeConstraints(spec.GCP.Constraints.VolumeTypes, fldPath.Child("gcp", "constraints", "volumeTypes"))...)
 		allErrs = append(allErrs, validateZones(spec.GCP.Constraints.Zones, fldPath.Child("gcp", "constraints", "zones"))...)
 	}
@@ -206,6 +214,24 @@ func validateMachineTypeConstraints(machineTypes []garden.MachineType, fldPath *
 	return allErrs
 }
 
+// validateMachineTypeNameReferences validates that the given names refer to machine types defined in <machineTypes>.
+func validateMachineTypeNameReferences(names []string, machineTypes []garden.MachineType, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	validNames := sets.NewString()
+	for _, machineType := range machineTypes {
+		validNames.Insert(machineType.Name)
+	}
+
+	for i, name := range names {
+		if !validNames.Has(name) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), name, validNames.List()))
+		}
+	}
+
+	return allErrs
+}
+
 func validateMachineImageNames(names []garden.MachineImageName, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -516,8 +542,41 @@ func ValidateQuotaUpdate(newQuota, oldQuota *garden.Quota) field.ErrorList {
 
 // ValidateQuotaStatusUpdate validates the status field of a Quota object.
 func ValidateQuotaStatusUpdate(newQuota, oldQuota *garden.Quota) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMetaUpdate(&newQuota.ObjectMeta, &oldQuota.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateQuotaStatus(&newQuota.Status, field.NewPath("status"))...)
+	return allErrs
+}
+
+func validateQuotaStatus(quotaStatus *garden.QuotaStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateQuotaUsage(quotaStatus.Used, fldPath.Child("used"))...)
+
+	for i, shoot := range quotaStatus.Shoots {
+		idxPath := fldPath.Child("shoots").Index(i)
+		if len(shoot.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must specify the name of the Shoot"))
+		}
+		if len(shoot.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must specify the namespace of the Shoot"))
+		}
+		allErrs = append(allErrs, validateQuotaUsage(shoot.Used, idxPath.Child("used"))...)
+	}
+
+	return allErrs
+}
+
+func validateQuotaUsage(used corev1.ResourceList, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	for k, v := range used {
+		keyPath := fldPath.Key(string(k))
+		if !isValidQuotaMetric(corev1.ResourceName(k)) {
+			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("%s is no supported quota metric", string(k))))
+		}
+		allErrs = append(allErrs, validateResourceQuantityValue(string(k), v, keyPath)...)
+	}
+
 	return allErrs
 }
 
@@ -548,6 +607,9 @@ func isValidQuotaMetric(metric corev1.ResourceName) bool {
 		garden.QuotaMetricCPU,
 		garden.QuotaMetricGPU,
 		garden.QuotaMetricMemory,
+		garden.QuotaMetricCPUPreemptible,
+		garden.QuotaMetricGPUPreemptible,
+		garden.QuotaMetricMemoryPreemptible,
 		garden.QuotaMetricStorageStandard,
 		garden.QuotaMetricStoragePremium,
 		garden.QuotaMetricLoadbalancer:
@@ -647,6 +709,7 @@ func ValidateShootUpdate(newShoot, oldShoot *garden.Shoot) field.ErrorList {
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShoot.ObjectMeta, &oldShoot.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateShootSpecUpdate(&newShoot.Spec, &oldShoot.Spec, newShoot.DeletionTimestamp != nil, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateSeedUpdate(&newShoot.Spec, &oldShoot.Spec, &oldShoot.Status, field.NewPath("spec"))...)
 	allErrs = append(allErrs, ValidateShoot(newShoot)...)
 
 	return allErrs
@@ -665,10 +728,12 @@ func ValidateShootSpec(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorL
 
 	allErrs = append(allErrs, validateAddons(spec.Addons, fldPath.Child("addons"))...)
 	allErrs = append(allErrs, validateBackup(spec.Backup, provider, fldPath.Child("backup"))...)
-	allErrs = append(allErrs, validateCloud(spec.Cloud, fldPath.Child("cloud"))...)
+	allErrs = append(allErrs, validateCloud(spec.Cloud, spec.Kubernetes.Version, fldPath.Child("cloud"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
+	allErrs = append(allErrs, validateHibernation(spec.Hibernation, fldPath.Child("hibernation"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"))...)
+	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
 
 	if spec.DNS.Provider == garden.DNSUnmanaged {
 		if spec.Addons != nil && spec.Addons.Monocular != nil && spec.Addons.Monocular.Enabled {
@@ -739,7 +804,7 @@ func validateBackup(backup *garden.Backup, cloudProvider garden.CloudProvider, f
 	return allErrs
 }
 
-func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
+func validateCloud(cloud garden.Cloud, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	workerNames := make(map[string]bool)
 
@@ -800,7 +865,7 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		}
 		for i, worker := range aws.Workers {
 			idxPath := awsPath.Child("workers").Index(i)
-			allErrs = append(allErrs, validateWorker(worker.Worker, idxPath)...)
+			allErrs = append(allErrs, validateWorker(worker.Worker, kubernetesVersion, idxPath)...)
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
 			if workerNames[worker.Name] {
@@ -853,7 +918,7 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		}
 		for i, worker := range azure.Workers {
 			idxPath := azurePath.Child("workers").Index(i)
-			allErrs = append(allErrs, validateWorker(worker.Worker, idxPath)...)
+			allErrs = append(allErrs, validateWorker(worker.Worker, kubernetesVersion, idxPath)...)
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
 			if worker.AutoScalerMax != worker.AutoScalerMin {
@@ -903,7 +968,7 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		}
 		for i, worker := range gcp.Workers {
 			idxPath := gcpPath.Child("workers").Index(i)
-			allErrs = append(allErrs, validateWorker(worker.Worker, idxPath)...)
+			allErrs = append(allErrs, validateWorker(worker.Worker, kubernetesVersion, idxPath)...)
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
 			if workerNames[worker.Name] {
@@ -949,7 +1014,7 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		}
 		for i, worker := range openStack.Workers {
 			idxPath := openStackPath.Child("workers").Index(i)
-			allErrs = append(allErrs, validateWorker(worker.Worker, idxPath)...)
+			allErrs = append(allErrs, validateWorker(worker.Worker, kubernetesVersion, idxPath)...)
 			if worker.AutoScalerMax != worker.AutoScalerMin {
 				allErrs = append(allErrs, field.Forbidden(idxPath.Child("autoScalerMax"), "maximum value must be equal to minimum value"))
 			}
@@ -963,6 +1028,32 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// validateSeedUpdate validates the Seed reference of a Shoot object before an update. Changing the Seed triggers the
+// migration of the control plane, hence, the reference must not be removed, and a hibernated control plane cannot be
+// migrated. The Seed which currently hosts the control plane is only known once it has been recorded in <oldStatus>
+// (by the first successful reconciliation), and before that the control plane cannot be migrated either.
+func validateSeedUpdate(newSpec, oldSpec *garden.ShootSpec, oldStatus *garden.ShootStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldSpec.Cloud.Seed == nil || apiequality.Semantic.DeepEqual(newSpec.Cloud.Seed, oldSpec.Cloud.Seed) {
+		return allErrs
+	}
+
+	seedPath := fldPath.Child("cloud", "seed")
+	if newSpec.Cloud.Seed == nil {
+		allErrs = append(allErrs, field.Required(seedPath, "seed must not be removed once it has been assigned"))
+		return allErrs
+	}
+	if (oldSpec.Hibernation != nil && oldSpec.Hibernation.Enabled) || (newSpec.Hibernation != nil && newSpec.Hibernation.Enabled) {
+		allErrs = append(allErrs, field.Forbidden(seedPath, "the control plane of a hibernated Shoot cannot be migrated to another seed"))
+	}
+	if oldStatus.Seed == nil {
+		allErrs = append(allErrs, field.Forbidden(seedPath, "the control plane cannot be migrated to another seed before the Shoot has been reconciled successfully"))
+	}
+
+	return allErrs
+}
+
 // ValidateShootSpecUpdate validates the specification of a Shoot object.
 func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimestampSet bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -974,7 +1065,6 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimesta
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Profile, oldSpec.Cloud.Profile, fldPath.Child("cloud", "profile"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Region, oldSpec.Cloud.Region, fldPath.Child("cloud", "region"))...)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Seed, oldSpec.Cloud.Seed, fldPath.Child("cloud", "seed"))...)
 
 	awsPath := fldPath.Child("cloud", "aws")
 	if oldSpec.Cloud.AWS != nil && newSpec.Cloud.AWS == nil {
@@ -1014,6 +1104,8 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimesta
 
 	allErrs = append(allErrs, validateDNSUpdate(newSpec.DNS, oldSpec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
+	allErrs = append(allErrs, validateKubeProxyModeUpdate(newSpec.Kubernetes.KubeProxy, oldSpec.Kubernetes.KubeProxy, fldPath.Child("kubernetes", "kubeProxy", "mode"))...)
+	allErrs = append(allErrs, validateKubeletCPUManagerPolicyUpdate(newSpec.Kubernetes.Kubelet, oldSpec.Kubernetes.Kubelet, fldPath.Child("kubernetes", "kubelet", "cpuManagerPolicy"))...)
 
 	return allErrs
 }
@@ -1034,6 +1126,33 @@ func validateDNSUpdate(new, old garden.DNS, fldPath *field.Path) field.ErrorList
 	return allErrs
 }
 
+// validateKubeProxyModeUpdate forbids changing the proxy mode of kube-proxy as the rules of the previous mode would be
+// left behind on the existing nodes. An unset mode is equivalent to the default mode IPTables.
+func validateKubeProxyModeUpdate(new, old *garden.KubeProxyConfig, fldPath *field.Path) field.ErrorList {
+	mode := func(kp *garden.KubeProxyConfig) garden.ProxyMode {
+		if kp == nil || kp.Mode == nil {
+			return garden.ProxyModeIPTables
+		}
+		return *kp.Mode
+	}
+
+	return apivalidation.ValidateImmutableField(mode(new), mode(old), fldPath)
+}
+
+// validateKubeletCPUManagerPolicyUpdate forbids changing the CPU manager policy of the kubelet as the kubelets on the
+// existing nodes would refuse to start with the state file of the previous policy. An unset policy is equivalent to
+// the default policy none.
+func validateKubeletCPUManagerPolicyUpdate(new, old *garden.KubeletConfig, fldPath *field.Path) field.ErrorList {
+	policy := func(kubelet *garden.KubeletConfig) string {
+		if kubelet == nil || kubelet.CPUManagerPolicy == nil {
+			return garden.KubeletCPUManagerPolicyNone
+		}
+		return *kubelet.CPUManagerPolicy
+	}
+
+	return apivalidation.ValidateImmutableField(policy(new), policy(old), fldPath)
+}
+
 func validateKubernetesVersionUpdate(new, old string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1142,6 +1261,393 @@ func validateKubernetes(kubernetes garden.Kubernetes, fldPath *field.Path) field
 				allErrs = append(allErrs, field.Invalid(oidcPath.Child("usernamePrefix"), oidc.UsernamePrefix, "username prefix cannot be empty when key is provided"))
 			}
 		}
+
+		allErrs = append(allErrs, validateAuditConfig(kubeAPIServer.AuditConfig, fldPath.Child("kubeAPIServer", "auditConfig"))...)
+		allErrs = append(allErrs, validateAdmissionPlugins(kubeAPIServer.AdmissionPlugins, kubernetes.Version, fldPath.Child("kubeAPIServer", "admissionPlugins"))...)
+	}
+
+	allErrs = append(allErrs, validateKubeControllerManager(kubernetes.KubeControllerManager, fldPath.Child("kubeControllerManager"))...)
+	allErrs = append(allErrs, validateKubeProxy(kubernetes.KubeProxy, kubernetes.Version, fldPath.Child("kubeProxy"))...)
+	allErrs = append(allErrs, validateKubelet(kubernetes.Kubelet, fldPath.Child("kubelet"))...)
+
+	return allErrs
+}
+
+func validateKubeControllerManager(kcm *garden.KubeControllerManagerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kcm == nil {
+		return allErrs
+	}
+
+	if hpa := kcm.HorizontalPodAutoscalerConfig; hpa != nil {
+		hpaPath := fldPath.Child("horizontalPodAutoscaler")
+
+		allErrs = append(allErrs, validatePositiveDuration(hpa.SyncPeriod, hpaPath.Child("syncPeriod"))...)
+		allErrs = append(allErrs, validatePositiveDuration(hpa.DownscaleDelay, hpaPath.Child("downscaleDelay"))...)
+		allErrs = append(allErrs, validatePositiveDuration(hpa.UpscaleDelay, hpaPath.Child("upscaleDelay"))...)
+	}
+	allErrs = append(allErrs, validatePositiveDuration(kcm.NodeMonitorGracePeriod, fldPath.Child("nodeMonitorGracePeriod"))...)
+
+	return allErrs
+}
+
+func validateKubeProxy(kp *garden.KubeProxyConfig, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kp == nil || kp.Mode == nil {
+		return allErrs
+	}
+
+	switch mode := *kp.Mode; mode {
+	case garden.ProxyModeIPTables:
+	case garden.ProxyModeIPVS:
+		if supported, err := utils.CompareVersions(kubernetesVersion, ">=", "1.10"); err == nil && !supported {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("mode"), fmt.Sprintf("proxy mode %q requires at least Kubernetes version 1.10", mode)))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), mode, []string{string(garden.ProxyModeIPTables), string(garden.ProxyModeIPVS)}))
+	}
+
+	return allErrs
+}
+
+func validateKubelet(kubelet *garden.KubeletConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kubelet == nil {
+		return allErrs
+	}
+
+	if policy := kubelet.CPUManagerPolicy; policy != nil && *policy != garden.KubeletCPUManagerPolicyNone && *policy != garden.KubeletCPUManagerPolicyStatic {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("cpuManagerPolicy"), *policy, []string{garden.KubeletCPUManagerPolicyNone, garden.KubeletCPUManagerPolicyStatic}))
+	}
+	if maxPods := kubelet.MaxPods; maxPods != nil && *maxPods <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPods"), *maxPods, "maximum number of pods must be greater than 0"))
+	}
+	if gracePeriod := kubelet.EvictionMaxPodGracePeriod; gracePeriod != nil && *gracePeriod < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("evictionMaxPodGracePeriod"), *gracePeriod, "eviction max pod grace period must not be negative"))
+	}
+	allErrs = append(allErrs, validatePositiveDuration(kubelet.EvictionPressureTransitionPeriod, fldPath.Child("evictionPressureTransitionPeriod"))...)
+	allErrs = append(allErrs, validateKubeletEviction(kubelet.EvictionHard, fldPath.Child("evictionHard"))...)
+	allErrs = append(allErrs, validateKubeletEviction(kubelet.EvictionSoft, fldPath.Child("evictionSoft"))...)
+
+	if gracePeriod := kubelet.EvictionSoftGracePeriod; gracePeriod != nil {
+		gracePeriodPath := fldPath.Child("evictionSoftGracePeriod")
+
+		allErrs = append(allErrs, validatePositiveDuration(gracePeriod.MemoryAvailable, gracePeriodPath.Child("memoryAvailable"))...)
+		allErrs = append(allErrs, validatePositiveDuration(gracePeriod.ImageFSAvailable, gracePeriodPath.Child("imageFSAvailable"))...)
+		allErrs = append(allErrs, validatePositiveDuration(gracePeriod.ImageFSInodesFree, gracePeriodPath.Child("imageFSInodesFree"))...)
+		allErrs = append(allErrs, validatePositiveDuration(gracePeriod.NodeFSAvailable, gracePeriodPath.Child("nodeFSAvailable"))...)
+		allErrs = append(allErrs, validatePositiveDuration(gracePeriod.NodeFSInodesFree, gracePeriodPath.Child("nodeFSInodesFree"))...)
+	}
+
+	return allErrs
+}
+
+func validateKubeletEviction(eviction *garden.KubeletConfigEviction, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if eviction == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateKubeletEvictionThreshold(eviction.MemoryAvailable, fldPath.Child("memoryAvailable"))...)
+	allErrs = append(allErrs, validateKubeletEvictionThreshold(eviction.ImageFSAvailable, fldPath.Child("imageFSAvailable"))...)
+	allErrs = append(allErrs, validateKubeletEvictionThreshold(eviction.ImageFSInodesFree, fldPath.Child("imageFSInodesFree"))...)
+	allErrs = append(allErrs, validateKubeletEvictionThreshold(eviction.NodeFSAvailable, fldPath.Child("nodeFSAvailable"))...)
+	allErrs = append(allErrs, validateKubeletEvictionThreshold(eviction.NodeFSInodesFree, fldPath.Child("nodeFSInodesFree"))...)
+
+	return allErrs
+}
+
+// validateKubeletEvictionThreshold checks whether the given threshold is either a percentage or a resource quantity.
+func validateKubeletEvictionThreshold(threshold *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if threshold == nil {
+		return allErrs
+	}
+
+	if strings.HasSuffix(*threshold, "%") {
+		percentage, err := strconv.ParseFloat(strings.TrimSuffix(*threshold, "%"), 64)
+		if err != nil || percentage <= 0 || percentage > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath, *threshold, "threshold must be a percentage between 0 and 100"))
+		}
+		return allErrs
+	}
+
+	quantity, err := resource.ParseQuantity(*threshold)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, *threshold, "threshold must be either a percentage or a resource quantity"))
+	} else if quantity.Sign() < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *threshold, "threshold must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validatePositiveDuration(duration *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if duration != nil && duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration.Duration.String(), "duration must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// admissionPluginsVersionConstraints contains the admission plugins which may be configured for the kube-apiserver
+// of a Shoot and the constraints for the Kubernetes versions supporting them.
+var admissionPluginsVersionConstraints = map[string]string{
+	"AlwaysPullImages":                     ">= 1.7",
+	"DefaultStorageClass":                  ">= 1.7",
+	"DefaultTolerationSeconds":             ">= 1.7",
+	"DenyEscalatingExec":                   ">= 1.7",
+	"EventRateLimit":                       ">= 1.9",
+	"ExtendedResourceToleration":           ">= 1.9",
+	"Initializers":                         ">= 1.7",
+	"LimitPodHardAntiAffinityTopology":     ">= 1.7",
+	"LimitRanger":                          ">= 1.7",
+	"MutatingAdmissionWebhook":             ">= 1.9",
+	"NamespaceLifecycle":                   ">= 1.7",
+	"NodeRestriction":                      ">= 1.7",
+	"OwnerReferencesPermissionEnforcement": ">= 1.7",
+	"PersistentVolumeClaimResize":          ">= 1.8",
+	"PersistentVolumeLabel":                ">= 1.7",
+	"PodNodeSelector":                      ">= 1.7",
+	"PodPreset":                            ">= 1.7",
+	"PodSecurityPolicy":                    ">= 1.7",
+	"PodTolerationRestriction":             ">= 1.7",
+	"Priority":                             ">= 1.8",
+	"ResourceQuota":                        ">= 1.7",
+	"SecurityContextDeny":                  ">= 1.7",
+	"ServiceAccount":                       ">= 1.7",
+	"StorageObjectInUseProtection":         ">= 1.10",
+	"ValidatingAdmissionWebhook":           ">= 1.9",
+}
+
+// requiredAdmissionPlugins contains the admission plugins which Gardener enables by default and which must not be
+// disabled as the security and the resource isolation of a Shoot cluster depend on them.
+var requiredAdmissionPlugins = sets.NewString(
+	"LimitRanger",
+	"NamespaceLifecycle",
+	"NodeRestriction",
+	"ResourceQuota",
+	"ServiceAccount",
+)
+
+// admissionPluginsRequiringConfig contains the admission plugins which prevent the kube-apiserver from starting if
+// they are enabled without a configuration.
+var admissionPluginsRequiringConfig = sets.NewString(
+	"EventRateLimit",
+)
+
+func validateAdmissionPlugins(plugins []garden.AdmissionPlugin, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := map[string]bool{}
+
+	for i, plugin := range plugins {
+		idxPath := fldPath.Index(i)
+
+		if len(plugin.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+			continue
+		}
+
+		if names[plugin.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		names[plugin.Name] = true
+
+		if plugin.Enabled != nil && !*plugin.Enabled && requiredAdmissionPlugins.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("enabled"), fmt.Sprintf("admission plugin %q is required and must not be disabled", plugin.Name)))
+		}
+
+		constraint, ok := admissionPluginsVersionConstraints[plugin.Name]
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), plugin.Name, supportedAdmissionPlugins()))
+		} else if supported, err := utils.CheckVersionMeetsConstraint(kubernetesVersion, constraint); err == nil && !supported {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), fmt.Sprintf("admission plugin %q is not supported by Kubernetes version %s (%s)", plugin.Name, kubernetesVersion, constraint)))
+		}
+
+		if plugin.Config == nil && (plugin.Enabled == nil || *plugin.Enabled) && admissionPluginsRequiringConfig.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Required(idxPath.Child("config"), fmt.Sprintf("admission plugin %q requires a configuration", plugin.Name)))
+		}
+
+		if plugin.Config != nil {
+			if len(*plugin.Config) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("config"), *plugin.Config, "config cannot be empty when key is provided"))
+			} else if _, err := yaml.YAMLToJSON([]byte(*plugin.Config)); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("config"), *plugin.Config, fmt.Sprintf("config is not a valid YAML or JSON document: %v", err)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func supportedAdmissionPlugins() []string {
+	var plugins []string
+	for name := range admissionPluginsVersionConstraints {
+		plugins = append(plugins, name)
+	}
+	sort.Strings(plugins)
+	return plugins
+}
+
+func validateAuditConfig(auditConfig *garden.AuditConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if auditConfig == nil {
+		return allErrs
+	}
+
+	if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil {
+		if auditPolicy.ConfigMapRef == nil || len(auditPolicy.ConfigMapRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("auditPolicy", "configMapRef", "name"), "config map name must be provided when an audit policy is configured"))
+		}
+	}
+
+	if log := auditConfig.Log; log != nil && log.Format != nil {
+		if format := *log.Format; format != garden.AuditLogFormatJSON && format != garden.AuditLogFormatLegacy {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("log", "format"), format, []string{garden.AuditLogFormatJSON, garden.AuditLogFormatLegacy}))
+		}
+	}
+
+	if webhook := auditConfig.Webhook; webhook != nil {
+		if len(webhook.KubeconfigSecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("webhook", "kubeconfigSecretRef", "name"), "secret name must be provided when a webhook backend is configured"))
+		}
+		if webhook.Mode != nil {
+			if mode := *webhook.Mode; mode != garden.AuditWebhookModeBatch && mode != garden.AuditWebhookModeBlocking {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("webhook", "mode"), mode, []string{garden.AuditWebhookModeBatch, garden.AuditWebhookModeBlocking}))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateHibernation(hibernation *garden.Hibernation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if hibernation == nil {
+		return allErrs
+	}
+
+	for i, schedule := range hibernation.Schedules {
+		schedulePath := fldPath.Child("schedules").Index(i)
+
+		if schedule.Start == nil && schedule.End == nil {
+			allErrs = append(allErrs, field.Required(schedulePath, "either start or end (or both) must be specified"))
+		}
+		if schedule.Start != nil {
+			if _, err := utils.ParseCronSchedule(*schedule.Start); err != nil {
+				allErrs = append(allErrs, field.Invalid(schedulePath.Child("start"), *schedule.Start, fmt.Sprintf("start is not a valid cron spec: %v", err)))
+			}
+		}
+		if schedule.End != nil {
+			if _, err := utils.ParseCronSchedule(*schedule.End); err != nil {
+				allErrs = append(allErrs, field.Invalid(schedulePath.Child("end"), *schedule.End, fmt.Sprintf("end is not a valid cron spec: %v", err)))
+			}
+		}
+		if schedule.Location != nil {
+			if _, err := time.LoadLocation(*schedule.Location); err != nil {
+				allErrs = append(allErrs, field.Invalid(schedulePath.Child("location"), *schedule.Location, fmt.Sprintf("location is not a valid time zone: %v", err)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateMonitoring(monitoring *garden.Monitoring, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if monitoring == nil {
+		return allErrs
+	}
+
+	if monitoring.Alerting != nil {
+		allErrs = append(allErrs, validateAlerting(monitoring.Alerting, fldPath.Child("alerting"))...)
+	}
+
+	if monitoring.Rules != nil && len(monitoring.Rules.ConfigMapRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("rules", "configMapRef", "name"), "config map name must be provided"))
+	}
+
+	return allErrs
+}
+
+func validateAlerting(alerting *garden.Alerting, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		names          = map[string]bool{}
+		receiversPath  = fldPath.Child("receivers")
+		severities     = []string{garden.AlertSeverityWarning, garden.AlertSeverityCritical, garden.AlertSeverityBlocker}
+		receiverFields = "email, slack, pagerDuty or webhook"
+	)
+
+	for i, receiver := range alerting.Receivers {
+		idxPath := receiversPath.Index(i)
+
+		for _, msg := range validation.IsDNS1123Label(receiver.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), receiver.Name, msg))
+		}
+		if names[receiver.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), receiver.Name))
+		}
+		names[receiver.Name] = true
+
+		for j, severity := range receiver.Severities {
+			if !utils.ValueExists(severity, severities) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("severities").Index(j), severity, severities))
+			}
+		}
+
+		configured := 0
+		if receiver.Email != nil {
+			configured++
+			if len(receiver.Email.To) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("email", "to"), "must provide at least one email address"))
+			}
+			for j, to := range receiver.Email.To {
+				if !utils.TestEmail(to) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("email", "to").Index(j), to, "must be a valid email address"))
+				}
+			}
+		}
+		if receiver.Slack != nil {
+			configured++
+			allErrs = append(allErrs, validateAlertReceiverSecretRef(receiver.Slack.SecretRef, idxPath.Child("slack", "secretRef"))...)
+		}
+		if receiver.PagerDuty != nil {
+			configured++
+			allErrs = append(allErrs, validateAlertReceiverSecretRef(receiver.PagerDuty.SecretRef, idxPath.Child("pagerDuty", "secretRef"))...)
+		}
+		if receiver.Webhook != nil {
+			configured++
+			allErrs = append(allErrs, validateAlertReceiverSecretRef(receiver.Webhook.SecretRef, idxPath.Child("webhook", "secretRef"))...)
+		}
+
+		switch {
+		case configured == 0:
+			allErrs = append(allErrs, field.Required(idxPath, fmt.Sprintf("must configure one of %s", receiverFields)))
+		case configured > 1:
+			allErrs = append(allErrs, field.Forbidden(idxPath, fmt.Sprintf("must configure exactly one of %s", receiverFields)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateAlertReceiverSecretRef(secretRef corev1.LocalObjectReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(secretRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "secret name must be provided"))
 	}
 
 	return allErrs
@@ -1195,7 +1701,7 @@ func validateMaintenance(maintenance *garden.Maintenance, fldPath *field.Path) f
 	return allErrs
 }
 
-func validateWorker(worker garden.Worker, fldPath *field.Path) field.ErrorList {
+func validateWorker(worker garden.Worker, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if len(worker.Name) == 0 {
@@ -1218,6 +1724,142 @@ func validateWorker(worker garden.Worker, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoScalerMax"), "maximum value must not be less or equal than minimum value"))
 	}
 
+	allErrs = append(allErrs, metav1validation.ValidateLabels(worker.Labels, fldPath.Child("labels"))...)
+	for key := range worker.Labels {
+		if isReservedWorkerLabel(key) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("labels").Key(key), "label is managed by Gardener"))
+		}
+	}
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(worker.Annotations, fldPath.Child("annotations"))...)
+	for key := range worker.Annotations {
+		if strings.HasPrefix(key, workerLabelPrefix) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("annotations").Key(key), "annotation is managed by Gardener"))
+		}
+	}
+	allErrs = append(allErrs, validateWorkerTaints(worker.Taints, fldPath.Child("taints"))...)
+	if worker.KubeletVersion != nil {
+		allErrs = append(allErrs, validateKubeletVersionSkew(*worker.KubeletVersion, kubernetesVersion, fldPath.Child("kubeletVersion"))...)
+	}
+	allErrs = append(allErrs, validateWorkerRollingUpdate(worker.MaxSurge, worker.MaxUnavailable, fldPath)...)
+
+	return allErrs
+}
+
+func validateWorkerRollingUpdate(maxSurge, maxUnavailable *intstr.IntOrString, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if maxSurge != nil {
+		allErrs = append(allErrs, validateNonnegativeIntOrPercent(*maxSurge, fldPath.Child("maxSurge"))...)
+	}
+	if maxUnavailable != nil {
+		allErrs = append(allErrs, validateNonnegativeIntOrPercent(*maxUnavailable, fldPath.Child("maxUnavailable"))...)
+		if getPercentValue(*maxUnavailable) > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), maxUnavailable.String(), "must not be greater than 100%"))
+		}
+	}
+	if maxSurge != nil && maxUnavailable != nil && isZeroIntOrPercent(*maxSurge) && isZeroIntOrPercent(*maxUnavailable) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), maxUnavailable.String(), "must not be 0 when maxSurge is 0"))
+	}
+
+	return allErrs
+}
+
+func validateNonnegativeIntOrPercent(intOrPercent intstr.IntOrString, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch intOrPercent.Type {
+	case intstr.String:
+		if len(validation.IsValidPercent(intOrPercent.StrVal)) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, intOrPercent.StrVal, "must be an integer or percentage (e.g '5%')"))
+		}
+	case intstr.Int:
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(intOrPercent.IntValue()), fldPath)...)
+	}
+
+	return allErrs
+}
+
+// getPercentValue returns the percentage of the given <intOrPercent> or -1 if it is not a valid percentage.
+func getPercentValue(intOrPercent intstr.IntOrString) int {
+	if intOrPercent.Type != intstr.String || len(validation.IsValidPercent(intOrPercent.StrVal)) > 0 {
+		return -1
+	}
+	value, _ := strconv.Atoi(intOrPercent.StrVal[:len(intOrPercent.StrVal)-1])
+	return value
+}
+
+func isZeroIntOrPercent(intOrPercent intstr.IntOrString) bool {
+	if intOrPercent.Type == intstr.Int {
+		return intOrPercent.IntVal == 0
+	}
+	return getPercentValue(intOrPercent) == 0
+}
+
+// maxKubeletMinorVersionSkew is the number of minor versions the kubelet is allowed to be older than the control plane.
+const maxKubeletMinorVersionSkew = 2
+
+func validateKubeletVersionSkew(kubeletVersion, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	kubelet, err := semver.NewVersion(kubeletVersion)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, kubeletVersion, err.Error()))
+		return allErrs
+	}
+	controlPlane, err := semver.NewVersion(kubernetesVersion)
+	if err != nil {
+		// The Kubernetes version itself is validated elsewhere.
+		return allErrs
+	}
+
+	if kubelet.GreaterThan(controlPlane) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "kubelet version must not be newer than the kubernetes version"))
+	} else if kubelet.Major() != controlPlane.Major() || controlPlane.Minor()-kubelet.Minor() > maxKubeletMinorVersionSkew {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("kubelet version must not be more than %d minor versions older than the kubernetes version", maxKubeletMinorVersionSkew)))
+	}
+
+	return allErrs
+}
+
+// workerLabelPrefix is the prefix of the labels and annotations which are maintained by Gardener on the nodes of a
+// worker group.
+const workerLabelPrefix = "worker.garden.sapcloud.io/"
+
+func isReservedWorkerLabel(key string) bool {
+	return strings.HasPrefix(key, workerLabelPrefix) || key == "kubernetes.io/role" || key == "node-role.kubernetes.io/node"
+}
+
+var availableTaintEffects = sets.NewString(
+	string(corev1.TaintEffectNoSchedule),
+	string(corev1.TaintEffectPreferNoSchedule),
+	string(corev1.TaintEffectNoExecute),
+)
+
+func validateWorkerTaints(taints []corev1.Taint, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	taintKeyEffects := sets.NewString()
+
+	for i, taint := range taints {
+		idxPath := fldPath.Index(i)
+
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(taint.Key, idxPath.Child("key"))...)
+		for _, msg := range validation.IsValidLabelValue(taint.Value) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), taint.Value, msg))
+		}
+		if !availableTaintEffects.Has(string(taint.Effect)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("effect"), taint.Effect, availableTaintEffects.List()))
+		}
+		if taint.TimeAdded != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("timeAdded"), "must not be set"))
+		}
+
+		keyEffect := fmt.Sprintf("%s:%s", taint.Key, taint.Effect)
+		if taintKeyEffects.Has(keyEffect) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, keyEffect))
+		}
+		taintKeyEffects.Insert(keyEffect)
+	}
+
 	return allErrs
 }
 
@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	. "github.com/onsi/ginkgo"
@@ -973,6 +974,18 @@ var _ = Describe("validation", func() {
 						"Field": Equal(fmt.Sprintf("spec.%s.constraints.machineTypes[0].memory", fldPath)),
 					}))
 				})
+
+				It("should forbid preemptibleMachineTypes referencing undefined machine types", func() {
+					gcpCloudProfile.Spec.GCP.Constraints.PreemptibleMachineTypes = []string{machineType.Name, "unknown"}
+
+					errorList := ValidateCloudProfile(gcpCloudProfile)
+
+					Expect(len(errorList)).To(Equal(1))
+					Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal(fmt.Sprintf("spec.%s.constraints.preemptibleMachineTypes[1]", fldPath)),
+					}))
+				})
 			})
 
 			Context("volume types validation", func() {
@@ -1496,6 +1509,76 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.metrics[key]"),
 			}))
 		})
+
+		Context("status", func() {
+			It("should allow a valid Quota status", func() {
+				newQuota := prepareQuotaForUpdate(quota)
+				newQuota.Status = garden.QuotaStatus{
+					Used: corev1.ResourceList{
+						"cpu":    resource.MustParse("12"),
+						"memory": resource.MustParse("48Gi"),
+					},
+					Shoots: []garden.QuotaShootUsage{
+						{
+							Name:      "shoot-1",
+							Namespace: "garden-dev",
+							Used: corev1.ResourceList{
+								"cpu":    resource.MustParse("12"),
+								"memory": resource.MustParse("48Gi"),
+							},
+						},
+					},
+				}
+
+				errorList := ValidateQuotaStatusUpdate(newQuota, quota)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid invalid used resources and incomplete Shoot usages", func() {
+				newQuota := prepareQuotaForUpdate(quota)
+				newQuota.Status = garden.QuotaStatus{
+					Used: corev1.ResourceList{
+						"key": resource.MustParse("-1"),
+					},
+					Shoots: []garden.QuotaShootUsage{
+						{
+							Used: corev1.ResourceList{
+								"key": resource.MustParse("-1"),
+							},
+						},
+					},
+				}
+
+				errorList := ValidateQuotaStatusUpdate(newQuota, quota)
+
+				Expect(len(errorList)).To(Equal(6))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.used[key]"),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.used[key]"),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.shoots[0].name"),
+				}))
+				Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.shoots[0].namespace"),
+				}))
+				Expect(*errorList[4]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.shoots[0].used[key]"),
+				}))
+				Expect(*errorList[5]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.shoots[0].used[key]"),
+				}))
+			})
+		})
 	})
 
 	Describe("#ValidateSecretBinding", func() {
@@ -1792,11 +1875,10 @@ var _ = Describe("validation", func() {
 			newShoot.Spec.Cloud.SecretBindingRef = corev1.LocalObjectReference{
 				Name: "another-reference",
 			}
-			newShoot.Spec.Cloud.Seed = makeStringPointer("another-seed")
 
 			errorList := ValidateShootUpdate(newShoot, shoot)
 
-			Expect(len(errorList)).To(Equal(3))
+			Expect(len(errorList)).To(Equal(2))
 			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeInvalid),
 				"Field": Equal("spec.cloud.profile"),
@@ -1805,8 +1887,59 @@ var _ = Describe("validation", func() {
 				"Type":  Equal(field.ErrorTypeInvalid),
 				"Field": Equal("spec.cloud.region"),
 			}))
-			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
+		})
+
+		It("should allow changing the seed in order to migrate the control plane", func() {
+			shoot.Spec.Cloud.Seed = makeStringPointer("seed")
+			shoot.Status.Seed = makeStringPointer("seed")
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Cloud.Seed = makeStringPointer("another-seed")
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid removing the seed", func() {
+			shoot.Spec.Cloud.Seed = makeStringPointer("seed")
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Cloud.Seed = nil
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.cloud.seed"),
+			}))
+		})
+
+		It("should forbid changing the seed of a hibernated shoot", func() {
+			shoot.Spec.Cloud.Seed = makeStringPointer("seed")
+			shoot.Status.Seed = makeStringPointer("seed")
+			shoot.Spec.Hibernation = &garden.Hibernation{Enabled: true}
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Cloud.Seed = makeStringPointer("another-seed")
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.cloud.seed"),
+			}))
+		})
+
+		It("should forbid changing the seed as long as the seed hosting the control plane is unknown", func() {
+			shoot.Spec.Cloud.Seed = makeStringPointer("seed")
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Cloud.Seed = makeStringPointer("another-seed")
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
 				"Field": Equal("spec.cloud.seed"),
 			}))
 		})
@@ -2027,6 +2160,164 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should allow valid worker labels, annotations and taints", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].Labels = map[string]string{"dedicated": "gpu"}
+				shoot.Spec.Cloud.AWS.Workers[0].Annotations = map[string]string{"example.com/owner": "team a"}
+				shoot.Spec.Cloud.AWS.Workers[0].Taints = []corev1.Taint{
+					{
+						Key:    "dedicated",
+						Value:  "gpu",
+						Effect: corev1.TaintEffectNoSchedule,
+					},
+					{
+						Key:    "dedicated",
+						Effect: corev1.TaintEffectNoExecute,
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid invalid or reserved worker labels, annotations and taints", func() {
+				now := metav1.Now()
+				shoot.Spec.Cloud.AWS.Workers[0].Labels = map[string]string{
+					"worker.garden.sapcloud.io/group": "foo",
+				}
+				shoot.Spec.Cloud.AWS.Workers[0].Annotations = map[string]string{
+					"worker.garden.sapcloud.io/managed-labels": "foo",
+				}
+				shoot.Spec.Cloud.AWS.Workers[0].Taints = []corev1.Taint{
+					{
+						Key:    "in valid",
+						Value:  "gpu",
+						Effect: corev1.TaintEffectNoSchedule,
+					},
+					{
+						Key:       "dedicated",
+						Value:     "in valid",
+						Effect:    "Foo",
+						TimeAdded: &now,
+					},
+					{
+						Key:    "dedicated",
+						Effect: "Foo",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(8))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].labels[worker.garden.sapcloud.io/group]", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].annotations[worker.garden.sapcloud.io/managed-labels]", fldPath)),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].taints[0].key", fldPath)),
+				}))
+				Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].taints[1].value", fldPath)),
+				}))
+				Expect(*errorList[4]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].taints[1].effect", fldPath)),
+				}))
+				Expect(*errorList[5]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].taints[1].timeAdded", fldPath)),
+				}))
+				Expect(*errorList[6]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].taints[2].effect", fldPath)),
+				}))
+				Expect(*errorList[7]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].taints[2]", fldPath)),
+				}))
+			})
+
+			It("should allow kubelet versions within the version skew policy", func() {
+				kubeletVersion := "1.6.13"
+				shoot.Spec.Cloud.AWS.Workers[0].KubeletVersion = &kubeletVersion
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid kubelet versions violating the version skew policy", func() {
+				var (
+					newerKubeletVersion = "1.9.0"
+					olderKubeletVersion = "1.5.8"
+				)
+				shoot.Spec.Cloud.AWS.Workers = append(shoot.Spec.Cloud.AWS.Workers, *shoot.Spec.Cloud.AWS.Workers[0].DeepCopy())
+				shoot.Spec.Cloud.AWS.Workers[0].KubeletVersion = &newerKubeletVersion
+				shoot.Spec.Cloud.AWS.Workers[1].Name = "worker-2"
+				shoot.Spec.Cloud.AWS.Workers[1].KubeletVersion = &olderKubeletVersion
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubeletVersion", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[1].kubeletVersion", fldPath)),
+				}))
+			})
+
+			It("should allow valid rolling update settings of the workers", func() {
+				var (
+					maxSurge       = intstr.FromString("25%")
+					maxUnavailable = intstr.FromInt(0)
+				)
+				shoot.Spec.Cloud.AWS.Workers[0].MaxSurge = &maxSurge
+				shoot.Spec.Cloud.AWS.Workers[0].MaxUnavailable = &maxUnavailable
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid invalid rolling update settings of the workers", func() {
+				var (
+					invalidMaxSurge       = intstr.FromString("foo")
+					invalidMaxUnavailable = intstr.FromString("150%")
+					zero                  = intstr.FromInt(0)
+				)
+				shoot.Spec.Cloud.AWS.Workers = append(shoot.Spec.Cloud.AWS.Workers, *shoot.Spec.Cloud.AWS.Workers[0].DeepCopy())
+				shoot.Spec.Cloud.AWS.Workers[0].MaxSurge = &invalidMaxSurge
+				shoot.Spec.Cloud.AWS.Workers[0].MaxUnavailable = &invalidMaxUnavailable
+				shoot.Spec.Cloud.AWS.Workers[1].Name = "worker-2"
+				shoot.Spec.Cloud.AWS.Workers[1].MaxSurge = &zero
+				shoot.Spec.Cloud.AWS.Workers[1].MaxUnavailable = &zero
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(3))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].maxSurge", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].maxUnavailable", fldPath)),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[1].maxUnavailable", fldPath)),
+				}))
+			})
+
 			It("should forbid an empty zones list", func() {
 				shoot.Spec.Cloud.AWS.Zones = []string{}
 
@@ -2997,6 +3288,400 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
+		It("should forbid invalid audit configuration", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig = &garden.AuditConfig{
+				AuditPolicy: &garden.AuditPolicy{},
+				Log: &garden.AuditLogBackend{
+					Format: makeStringPointer("xml"),
+				},
+				Webhook: &garden.AuditWebhookBackend{
+					Mode: makeStringPointer("async"),
+				},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(4))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.auditPolicy.configMapRef.name"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.log.format"),
+			}))
+			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.webhook.kubeconfigSecretRef.name"),
+			}))
+			Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.webhook.mode"),
+			}))
+		})
+
+		It("should allow a valid audit configuration", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig = &garden.AuditConfig{
+				AuditPolicy: &garden.AuditPolicy{
+					ConfigMapRef: &corev1.LocalObjectReference{Name: "audit-policy"},
+				},
+				Log: &garden.AuditLogBackend{
+					Format: makeStringPointer(garden.AuditLogFormatJSON),
+				},
+				Webhook: &garden.AuditWebhookBackend{
+					KubeconfigSecretRef: corev1.LocalObjectReference{Name: "audit-webhook"},
+					Mode:                makeStringPointer(garden.AuditWebhookModeBlocking),
+				},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid unknown, unsupported and duplicate admission plugins", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins = []garden.AdmissionPlugin{
+				{Name: ""},
+				{Name: "FooBar"},
+				{Name: "StorageObjectInUseProtection"},
+				{Name: "PodNodeSelector", Config: makeStringPointer("podNodeSelectorPluginConfig: [")},
+				{Name: "PodNodeSelector"},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(5))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[0].name"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[1].name"),
+			}))
+			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[2].name"),
+			}))
+			Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[3].config"),
+			}))
+			Expect(*errorList[4]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[4].name"),
+			}))
+		})
+
+		It("should forbid the ImagePolicyWebhook admission plugin", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins = []garden.AdmissionPlugin{
+				{Name: "ImagePolicyWebhook", Config: makeStringPointer("imagePolicy:\n  kubeConfigFile: /etc/kubernetes/image-policy/kubeconfig")},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[0].name"),
+			}))
+		})
+
+		It("should require a configuration for admission plugins which cannot start without one", func() {
+			shoot.Spec.Kubernetes.Version = "1.10.1"
+			shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins = []garden.AdmissionPlugin{
+				{Name: "EventRateLimit"},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[0].config"),
+			}))
+		})
+
+		It("should forbid disabling required admission plugins", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins = []garden.AdmissionPlugin{
+				{Name: "NodeRestriction", Enabled: makeBoolPointer(false)},
+				{Name: "ServiceAccount", Enabled: makeBoolPointer(true)},
+				{Name: "ResourceQuota", Enabled: makeBoolPointer(false)},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(2))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[0].enabled"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[2].enabled"),
+			}))
+		})
+
+		It("should allow supported admission plugins", func() {
+			shoot.Spec.Kubernetes.Version = "1.10.1"
+			shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins = []garden.AdmissionPlugin{
+				{Name: "PodSecurityPolicy"},
+				{Name: "PodPreset", Enabled: makeBoolPointer(false)},
+				{Name: "PodNodeSelector", Config: makeStringPointer("podNodeSelectorPluginConfig:\n  clusterDefaultNodeSelector: role=worker")},
+				{Name: "EventRateLimit", Config: makeStringPointer("kind: Configuration\napiVersion: eventratelimit.admission.k8s.io/v1alpha1\nlimits:\n- type: Server\n  qps: 50\n  burst: 100")},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid invalid kube-controller-manager, kube-proxy and kubelet configuration", func() {
+			var (
+				negativeDuration = metav1.Duration{Duration: -time.Minute}
+				zero             = int32(0)
+				ipvs             = garden.ProxyModeIPVS
+			)
+
+			shoot.Spec.Kubernetes.KubeControllerManager = &garden.KubeControllerManagerConfig{
+				HorizontalPodAutoscalerConfig: &garden.HorizontalPodAutoscalerConfig{
+					SyncPeriod: &negativeDuration,
+				},
+				NodeMonitorGracePeriod: &negativeDuration,
+			}
+			shoot.Spec.Kubernetes.KubeProxy = &garden.KubeProxyConfig{
+				Mode: &ipvs,
+			}
+			shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+				CPUManagerPolicy: makeStringPointer("dynamic"),
+				MaxPods:          &zero,
+				EvictionHard: &garden.KubeletConfigEviction{
+					MemoryAvailable: makeStringPointer("120%"),
+					NodeFSAvailable: makeStringPointer("foo"),
+				},
+				EvictionSoftGracePeriod: &garden.KubeletConfigEvictionSoftGracePeriod{
+					MemoryAvailable: &negativeDuration,
+				},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(8))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubeControllerManager.horizontalPodAutoscaler.syncPeriod"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubeControllerManager.nodeMonitorGracePeriod"),
+			}))
+			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.kubernetes.kubeProxy.mode"),
+			}))
+			Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.kubernetes.kubelet.cpuManagerPolicy"),
+			}))
+			Expect(*errorList[4]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubelet.maxPods"),
+			}))
+			Expect(*errorList[5]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubelet.evictionHard.memoryAvailable"),
+			}))
+			Expect(*errorList[6]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubelet.evictionHard.nodeFSAvailable"),
+			}))
+			Expect(*errorList[7]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubelet.evictionSoftGracePeriod.memoryAvailable"),
+			}))
+		})
+
+		It("should allow valid kube-controller-manager, kube-proxy and kubelet configuration", func() {
+			var (
+				duration = metav1.Duration{Duration: time.Minute}
+				maxPods  = int32(50)
+				iptables = garden.ProxyModeIPTables
+			)
+
+			shoot.Spec.Kubernetes.KubeControllerManager = &garden.KubeControllerManagerConfig{
+				HorizontalPodAutoscalerConfig: &garden.HorizontalPodAutoscalerConfig{
+					SyncPeriod:     &duration,
+					DownscaleDelay: &duration,
+					UpscaleDelay:   &duration,
+				},
+				NodeMonitorGracePeriod: &duration,
+			}
+			shoot.Spec.Kubernetes.KubeProxy = &garden.KubeProxyConfig{
+				Mode: &iptables,
+			}
+			shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+				CPUManagerPolicy: makeStringPointer(garden.KubeletCPUManagerPolicyStatic),
+				MaxPods:          &maxPods,
+				EvictionHard: &garden.KubeletConfigEviction{
+					MemoryAvailable: makeStringPointer("1Gi"),
+					NodeFSAvailable: makeStringPointer("7.5%"),
+				},
+				EvictionPressureTransitionPeriod: &duration,
+				EvictionSoftGracePeriod: &garden.KubeletConfigEvictionSoftGracePeriod{
+					MemoryAvailable: &duration,
+				},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid changing the kube-proxy mode", func() {
+			ipvs := garden.ProxyModeIPVS
+			shoot.Spec.Kubernetes.Version = "1.10.1"
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Kubernetes.KubeProxy = &garden.KubeProxyConfig{
+				Mode: &ipvs,
+			}
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubeProxy.mode"),
+			}))
+		})
+
+		It("should allow setting the default kube-proxy mode explicitly", func() {
+			iptables := garden.ProxyModeIPTables
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Kubernetes.KubeProxy = &garden.KubeProxyConfig{
+				Mode: &iptables,
+			}
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid changing the CPU manager policy of the kubelet", func() {
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+				CPUManagerPolicy: makeStringPointer(garden.KubeletCPUManagerPolicyStatic),
+			}
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubelet.cpuManagerPolicy"),
+			}))
+		})
+
+		It("should allow setting the default CPU manager policy of the kubelet explicitly", func() {
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+				CPUManagerPolicy: makeStringPointer(garden.KubeletCPUManagerPolicyNone),
+			}
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid invalid alert receivers", func() {
+			shoot.Spec.Monitoring = &garden.Monitoring{
+				Alerting: &garden.Alerting{
+					Receivers: []garden.AlertReceiver{
+						{
+							Name:       "team-a",
+							Severities: []string{"info"},
+							Email:      &garden.EmailReceiver{To: []string{"not-an-email"}},
+						},
+						{
+							Name:  "team-a",
+							Slack: &garden.SlackReceiver{},
+						},
+						{
+							Name: "team-b",
+						},
+						{
+							Name:      "team-c",
+							PagerDuty: &garden.PagerDutyReceiver{SecretRef: corev1.LocalObjectReference{Name: "pagerduty"}},
+							Webhook:   &garden.WebhookReceiver{SecretRef: corev1.LocalObjectReference{Name: "webhook"}},
+						},
+					},
+				},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(6))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.monitoring.alerting.receivers[0].severities[0]"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.monitoring.alerting.receivers[0].email.to[0]"),
+			}))
+			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.monitoring.alerting.receivers[1].name"),
+			}))
+			Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.monitoring.alerting.receivers[1].slack.secretRef.name"),
+			}))
+			Expect(*errorList[4]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.monitoring.alerting.receivers[2]"),
+			}))
+			Expect(*errorList[5]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.monitoring.alerting.receivers[3]"),
+			}))
+		})
+
+		It("should allow valid alert receivers", func() {
+			shoot.Spec.Monitoring = &garden.Monitoring{
+				Alerting: &garden.Alerting{
+					Receivers: []garden.AlertReceiver{
+						{
+							Name:       "team-a",
+							Severities: []string{garden.AlertSeverityWarning, garden.AlertSeverityCritical},
+							Email:      &garden.EmailReceiver{To: []string{"team-a@example.com"}},
+						},
+						{
+							Name:  "team-b",
+							Slack: &garden.SlackReceiver{SecretRef: corev1.LocalObjectReference{Name: "slack"}, Channel: makeStringPointer("#alerts")},
+						},
+					},
+				},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid a monitoring rules config map reference without name", func() {
+			shoot.Spec.Monitoring = &garden.Monitoring{
+				Rules: &garden.MonitoringRules{},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.monitoring.rules.configMapRef.name"),
+			}))
+		})
+
 		It("should forbid kubernetes version downgrades", func() {
 			newShoot := prepareShootForUpdate(shoot)
 			newShoot.Spec.Kubernetes.Version = "1.7.2"
@@ -3023,6 +3708,61 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
+		Context("hibernation section", func() {
+			It("should allow valid hibernation schedules", func() {
+				start, end, location := "0 18 * * MON-FRI", "0 8 * * 1-5", "Europe/Berlin"
+				shoot.Spec.Hibernation = &garden.Hibernation{
+					Schedules: []garden.HibernationSchedule{
+						{Start: &start, End: &end, Location: &location},
+						{End: &end},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid schedules without start and end", func() {
+				shoot.Spec.Hibernation = &garden.Hibernation{
+					Schedules: []garden.HibernationSchedule{{}},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.hibernation.schedules[0]"),
+				}))
+			})
+
+			It("should forbid invalid cron specs and locations", func() {
+				start, end, location := "0 25 * * *", "foo", "Mars/Olympus_Mons"
+				shoot.Spec.Hibernation = &garden.Hibernation{
+					Schedules: []garden.HibernationSchedule{
+						{Start: &start, End: &end, Location: &location},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(3))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.hibernation.schedules[0].start"),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.hibernation.schedules[0].end"),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.hibernation.schedules[0].location"),
+				}))
+			})
+		})
+
 		Context("maintenance section", func() {
 			It("should forbid not specifying the maintenance section", func() {
 				shoot.Spec.Maintenance = nil
@@ -3165,8 +3905,19 @@ func makeStringPointer(s string) *string {
 	return &ptr
 }
 
+func makeBoolPointer(b bool) *bool {
+	ptr := b
+	return &ptr
+}
+
 func prepareShootForUpdate(shoot *garden.Shoot) *garden.Shoot {
 	s := shoot.DeepCopy()
 	s.ResourceVersion = "1"
 	return s
 }
+
+func prepareQuotaForUpdate(quota *garden.Quota) *garden.Quota {
+	q := quota.DeepCopy()
+	q.ResourceVersion = "1"
+	return q
+}
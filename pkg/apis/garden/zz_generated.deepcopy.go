@@ -22,7 +22,9 @@ package garden
 
 import (
 	v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -41,7 +43,9 @@ func (in *AWSCloud) DeepCopyInto(out *AWSCloud) {
 	if in.Workers != nil {
 		in, out := &in.Workers, &out.Workers
 		*out = make([]AWSWorker, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Zones != nil {
 		in, out := &in.Zones, &out.Zones
@@ -249,7 +253,16 @@ func (in *AWSVPC) DeepCopy() *AWSVPC {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSWorker) DeepCopyInto(out *AWSWorker) {
 	*out = *in
-	out.Worker = in.Worker
+	in.Worker.DeepCopyInto(&out.Worker)
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(AWSMachineImage)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -358,6 +371,239 @@ func (in *Addons) DeepCopy() *Addons {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionPlugin) DeepCopyInto(out *AdmissionPlugin) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionPlugin.
+func (in *AdmissionPlugin) DeepCopy() *AdmissionPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertReceiver) DeepCopyInto(out *AlertReceiver) {
+	*out = *in
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(EmailReceiver)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SlackReceiver)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.PagerDuty != nil {
+		in, out := &in.PagerDuty, &out.PagerDuty
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(PagerDutyReceiver)
+			**out = **in
+		}
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(WebhookReceiver)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertReceiver.
+func (in *AlertReceiver) DeepCopy() *AlertReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Alerting) DeepCopyInto(out *Alerting) {
+	*out = *in
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]AlertReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Alerting.
+func (in *Alerting) DeepCopy() *Alerting {
+	if in == nil {
+		return nil
+	}
+	out := new(Alerting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
+	*out = *in
+	if in.AuditPolicy != nil {
+		in, out := &in.AuditPolicy, &out.AuditPolicy
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(AuditPolicy)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(AuditLogBackend)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(AuditWebhookBackend)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditConfig.
+func (in *AuditConfig) DeepCopy() *AuditConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogBackend) DeepCopyInto(out *AuditLogBackend) {
+	*out = *in
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogBackend.
+func (in *AuditLogBackend) DeepCopy() *AuditLogBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditPolicy) DeepCopyInto(out *AuditPolicy) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.LocalObjectReference)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditPolicy.
+func (in *AuditPolicy) DeepCopy() *AuditPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhookBackend) DeepCopyInto(out *AuditWebhookBackend) {
+	*out = *in
+	out.KubeconfigSecretRef = in.KubeconfigSecretRef
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookBackend.
+func (in *AuditWebhookBackend) DeepCopy() *AuditWebhookBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhookBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureCloud) DeepCopyInto(out *AzureCloud) {
 	*out = *in
@@ -383,7 +629,9 @@ func (in *AzureCloud) DeepCopyInto(out *AzureCloud) {
 	if in.Workers != nil {
 		in, out := &in.Workers, &out.Workers
 		*out = make([]AzureWorker, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -567,7 +815,16 @@ func (in *AzureVNet) DeepCopy() *AzureVNet {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureWorker) DeepCopyInto(out *AzureWorker) {
 	*out = *in
-	out.Worker = in.Worker
+	in.Worker.DeepCopyInto(&out.Worker)
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(AzureMachineImage)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -597,6 +854,23 @@ func (in *Backup) DeepCopy() *Backup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Certificate) DeepCopyInto(out *Certificate) {
+	*out = *in
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Certificate.
+func (in *Certificate) DeepCopy() *Certificate {
+	if in == nil {
+		return nil
+	}
+	out := new(Certificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cloud) DeepCopyInto(out *Cloud) {
 	*out = *in
@@ -655,6 +929,15 @@ func (in *Cloud) DeepCopyInto(out *Cloud) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.SequentialWorkerRollout != nil {
+		in, out := &in.SequentialWorkerRollout, &out.SequentialWorkerRollout
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -891,6 +1174,27 @@ func (in *DNSProviderConstraint) DeepCopy() *DNSProviderConstraint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailReceiver) DeepCopyInto(out *EmailReceiver) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailReceiver.
+func (in *EmailReceiver) DeepCopy() *EmailReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPCloud) DeepCopyInto(out *GCPCloud) {
 	*out = *in
@@ -907,7 +1211,9 @@ func (in *GCPCloud) DeepCopyInto(out *GCPCloud) {
 	if in.Workers != nil {
 		in, out := &in.Workers, &out.Workers
 		*out = make([]GCPWorker, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Zones != nil {
 		in, out := &in.Zones, &out.Zones
@@ -948,6 +1254,11 @@ func (in *GCPConstraints) DeepCopyInto(out *GCPConstraints) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PreemptibleMachineTypes != nil {
+		in, out := &in.PreemptibleMachineTypes, &out.PreemptibleMachineTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.VolumeTypes != nil {
 		in, out := &in.VolumeTypes, &out.VolumeTypes
 		*out = make([]VolumeType, len(*in))
@@ -1056,7 +1367,25 @@ func (in *GCPVPC) DeepCopy() *GCPVPC {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPWorker) DeepCopyInto(out *GCPWorker) {
 	*out = *in
-	out.Worker = in.Worker
+	in.Worker.DeepCopyInto(&out.Worker)
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(GCPMachineImage)
+			**out = **in
+		}
+	}
+	if in.Preemptible != nil {
+		in, out := &in.Preemptible, &out.Preemptible
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1120,6 +1449,115 @@ func (in *HelmTiller) DeepCopy() *HelmTiller {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hibernation) DeepCopyInto(out *Hibernation) {
+	*out = *in
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]HibernationSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hibernation.
+func (in *Hibernation) DeepCopy() *Hibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(Hibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSchedule.
+func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorizontalPodAutoscalerConfig) DeepCopyInto(out *HorizontalPodAutoscalerConfig) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(meta_v1.Duration)
+			**out = **in
+		}
+	}
+	if in.DownscaleDelay != nil {
+		in, out := &in.DownscaleDelay, &out.DownscaleDelay
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(meta_v1.Duration)
+			**out = **in
+		}
+	}
+	if in.UpscaleDelay != nil {
+		in, out := &in.UpscaleDelay, &out.UpscaleDelay
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(meta_v1.Duration)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerConfig.
+func (in *HorizontalPodAutoscalerConfig) DeepCopy() *HorizontalPodAutoscalerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HorizontalPodAutoscalerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8SNetworks) DeepCopyInto(out *K8SNetworks) {
 	*out = *in
@@ -1221,6 +1659,22 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.AuditConfig != nil {
+		in, out := &in.AuditConfig, &out.AuditConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(AuditConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.AdmissionPlugins != nil {
+		in, out := &in.AdmissionPlugins, &out.AdmissionPlugins
+		*out = make([]AdmissionPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1238,6 +1692,24 @@ func (in *KubeAPIServerConfig) DeepCopy() *KubeAPIServerConfig {
 func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerConfig) {
 	*out = *in
 	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	if in.HorizontalPodAutoscalerConfig != nil {
+		in, out := &in.HorizontalPodAutoscalerConfig, &out.HorizontalPodAutoscalerConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(HorizontalPodAutoscalerConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.NodeMonitorGracePeriod != nil {
+		in, out := &in.NodeMonitorGracePeriod, &out.NodeMonitorGracePeriod
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(meta_v1.Duration)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1263,58 +1735,252 @@ func (in *KubeLego) DeepCopy() *KubeLego {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeLego)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeProxyConfig) DeepCopyInto(out *KubeProxyConfig) {
-	*out = *in
-	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	out := new(KubeLego)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyConfig) DeepCopyInto(out *KubeProxyConfig) {
+	*out = *in
+	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ProxyMode)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyConfig.
+func (in *KubeProxyConfig) DeepCopy() *KubeProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSchedulerConfig) DeepCopyInto(out *KubeSchedulerConfig) {
+	*out = *in
+	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerConfig.
+func (in *KubeSchedulerConfig) DeepCopy() *KubeSchedulerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSchedulerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	if in.CPUManagerPolicy != nil {
+		in, out := &in.CPUManagerPolicy, &out.CPUManagerPolicy
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.EvictionHard != nil {
+		in, out := &in.EvictionHard, &out.EvictionHard
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(KubeletConfigEviction)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.EvictionSoft != nil {
+		in, out := &in.EvictionSoft, &out.EvictionSoft
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(KubeletConfigEviction)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.EvictionSoftGracePeriod != nil {
+		in, out := &in.EvictionSoftGracePeriod, &out.EvictionSoftGracePeriod
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(KubeletConfigEvictionSoftGracePeriod)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.EvictionPressureTransitionPeriod != nil {
+		in, out := &in.EvictionPressureTransitionPeriod, &out.EvictionPressureTransitionPeriod
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(meta_v1.Duration)
+			**out = **in
+		}
+	}
+	if in.EvictionMaxPodGracePeriod != nil {
+		in, out := &in.EvictionMaxPodGracePeriod, &out.EvictionMaxPodGracePeriod
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int32)
+			**out = **in
+		}
+	}
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int32)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigEviction) DeepCopyInto(out *KubeletConfigEviction) {
+	*out = *in
+	if in.MemoryAvailable != nil {
+		in, out := &in.MemoryAvailable, &out.MemoryAvailable
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.ImageFSAvailable != nil {
+		in, out := &in.ImageFSAvailable, &out.ImageFSAvailable
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.ImageFSInodesFree != nil {
+		in, out := &in.ImageFSInodesFree, &out.ImageFSInodesFree
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.NodeFSAvailable != nil {
+		in, out := &in.NodeFSAvailable, &out.NodeFSAvailable
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.NodeFSInodesFree != nil {
+		in, out := &in.NodeFSInodesFree, &out.NodeFSInodesFree
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyConfig.
-func (in *KubeProxyConfig) DeepCopy() *KubeProxyConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigEviction.
+func (in *KubeletConfigEviction) DeepCopy() *KubeletConfigEviction {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeProxyConfig)
+	out := new(KubeletConfigEviction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeSchedulerConfig) DeepCopyInto(out *KubeSchedulerConfig) {
+func (in *KubeletConfigEvictionSoftGracePeriod) DeepCopyInto(out *KubeletConfigEvictionSoftGracePeriod) {
 	*out = *in
-	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerConfig.
-func (in *KubeSchedulerConfig) DeepCopy() *KubeSchedulerConfig {
-	if in == nil {
-		return nil
+	if in.MemoryAvailable != nil {
+		in, out := &in.MemoryAvailable, &out.MemoryAvailable
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(meta_v1.Duration)
+			**out = **in
+		}
+	}
+	if in.ImageFSAvailable != nil {
+		in, out := &in.ImageFSAvailable, &out.ImageFSAvailable
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(meta_v1.Duration)
+			**out = **in
+		}
+	}
+	if in.ImageFSInodesFree != nil {
+		in, out := &in.ImageFSInodesFree, &out.ImageFSInodesFree
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(meta_v1.Duration)
+			**out = **in
+		}
+	}
+	if in.NodeFSAvailable != nil {
+		in, out := &in.NodeFSAvailable, &out.NodeFSAvailable
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(meta_v1.Duration)
+			**out = **in
+		}
+	}
+	if in.NodeFSInodesFree != nil {
+		in, out := &in.NodeFSInodesFree, &out.NodeFSInodesFree
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(meta_v1.Duration)
+			**out = **in
+		}
 	}
-	out := new(KubeSchedulerConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
-	*out = *in
-	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
-func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigEvictionSoftGracePeriod.
+func (in *KubeletConfigEvictionSoftGracePeriod) DeepCopy() *KubeletConfigEvictionSoftGracePeriod {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeletConfig)
+	out := new(KubeletConfigEvictionSoftGracePeriod)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1573,6 +2239,57 @@ func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(Alerting)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MonitoringRules)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringRules) DeepCopyInto(out *MonitoringRules) {
+	*out = *in
+	out.ConfigMapRef = in.ConfigMapRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringRules.
+func (in *MonitoringRules) DeepCopy() *MonitoringRules {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Monocular) DeepCopyInto(out *Monocular) {
 	*out = *in
@@ -1702,7 +2419,9 @@ func (in *OpenStackCloud) DeepCopyInto(out *OpenStackCloud) {
 	if in.Workers != nil {
 		in, out := &in.Workers, &out.Workers
 		*out = make([]OpenStackWorker, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Zones != nil {
 		in, out := &in.Zones, &out.Zones
@@ -1911,7 +2630,16 @@ func (in *OpenStackRouter) DeepCopy() *OpenStackRouter {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackWorker) DeepCopyInto(out *OpenStackWorker) {
 	*out = *in
-	out.Worker = in.Worker
+	in.Worker.DeepCopyInto(&out.Worker)
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(OpenStackMachineImage)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1925,12 +2653,30 @@ func (in *OpenStackWorker) DeepCopy() *OpenStackWorker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagerDutyReceiver) DeepCopyInto(out *PagerDutyReceiver) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagerDutyReceiver.
+func (in *PagerDutyReceiver) DeepCopy() *PagerDutyReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(PagerDutyReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Quota) DeepCopyInto(out *Quota) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1985,6 +2731,29 @@ func (in *QuotaList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaShootUsage) DeepCopyInto(out *QuotaShootUsage) {
+	*out = *in
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaShootUsage.
+func (in *QuotaShootUsage) DeepCopy() *QuotaShootUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaShootUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 	*out = *in
@@ -2017,6 +2786,36 @@ func (in *QuotaSpec) DeepCopy() *QuotaSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaStatus) DeepCopyInto(out *QuotaStatus) {
+	*out = *in
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Shoots != nil {
+		in, out := &in.Shoots, &out.Shoots
+		*out = make([]QuotaShootUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaStatus.
+func (in *QuotaStatus) DeepCopy() *QuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 	*out = *in
@@ -2319,6 +3118,15 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 	}
 	in.Cloud.DeepCopyInto(&out.Cloud)
 	in.DNS.DeepCopyInto(&out.DNS)
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(Hibernation)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
 	if in.Maintenance != nil {
 		in, out := &in.Maintenance, &out.Maintenance
@@ -2329,6 +3137,15 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(Monitoring)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -2379,6 +3196,22 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 			*out = (*in).DeepCopy()
 		}
 	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]Certificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2392,6 +3225,32 @@ func (in *ShootStatus) DeepCopy() *ShootStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackReceiver) DeepCopyInto(out *SlackReceiver) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackReceiver.
+func (in *SlackReceiver) DeepCopy() *SlackReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VagrantConstraints) DeepCopyInto(out *VagrantConstraints) {
 	*out = *in
@@ -2480,9 +3339,74 @@ func (in *VolumeType) DeepCopy() *VolumeType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookReceiver) DeepCopyInto(out *WebhookReceiver) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookReceiver.
+func (in *WebhookReceiver) DeepCopy() *WebhookReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Worker) DeepCopyInto(out *Worker) {
 	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KubeletVersion != nil {
+		in, out := &in.KubeletVersion, &out.KubeletVersion
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(intstr.IntOrString)
+			**out = **in
+		}
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(intstr.IntOrString)
+			**out = **in
+		}
+	}
 	return
 }
 
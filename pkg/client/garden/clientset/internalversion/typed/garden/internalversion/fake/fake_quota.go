@@ -84,6 +84,18 @@ func (c *FakeQuotas) Update(quota *garden.Quota) (result *garden.Quota, err erro
 	return obj.(*garden.Quota), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeQuotas) UpdateStatus(quota *garden.Quota) (*garden.Quota, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(quotasResource, "status", c.ns, quota), &garden.Quota{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*garden.Quota), err
+}
+
 // Delete takes name of the quota and deletes it. Returns an error if one occurs.
 func (c *FakeQuotas) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
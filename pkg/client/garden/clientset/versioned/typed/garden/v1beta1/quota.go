@@ -21,6 +21,7 @@ type QuotasGetter interface {
 type QuotaInterface interface {
 	Create(*v1beta1.Quota) (*v1beta1.Quota, error)
 	Update(*v1beta1.Quota) (*v1beta1.Quota, error)
+	UpdateStatus(*v1beta1.Quota) (*v1beta1.Quota, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta1.Quota, error)
@@ -104,6 +105,22 @@ func (c *quotas) Update(quota *v1beta1.Quota) (result *v1beta1.Quota, err error)
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *quotas) UpdateStatus(quota *v1beta1.Quota) (result *v1beta1.Quota, err error) {
+	result = &v1beta1.Quota{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("quotas").
+		Name(quota.Name).
+		SubResource("status").
+		Body(quota).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the quota and deletes it. Returns an error if one occurs.
 func (c *quotas) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -49,6 +49,11 @@ func (c *Client) GetConfigMap(namespace, name string) (*corev1.ConfigMap, error)
 	return c.clientset.CoreV1().ConfigMaps(namespace).Get(name, metav1.GetOptions{})
 }
 
+// ListConfigMaps lists all ConfigMaps in a given <namespace>.
+func (c *Client) ListConfigMaps(namespace string, listOptions metav1.ListOptions) (*corev1.ConfigMapList, error) {
+	return c.clientset.CoreV1().ConfigMaps(namespace).List(listOptions)
+}
+
 // DeleteConfigMap deletes a ConfigMap object.
 func (c *Client) DeleteConfigMap(namespace, name string) error {
 	return c.clientset.CoreV1().ConfigMaps(namespace).Delete(name, &defaultDeleteOptions)
@@ -21,6 +21,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// GetNode returns the Node with the given <name>.
+func (c *Client) GetNode(name string) (*corev1.Node, error) {
+	return c.clientset.CoreV1().Nodes().Get(name, metav1.GetOptions{})
+}
+
 // ListNodes returns a list of Nodes.
 func (c *Client) ListNodes(listOptions metav1.ListOptions) (*corev1.NodeList, error) {
 	nodes, err := c.clientset.CoreV1().Nodes().List(listOptions)
@@ -32,3 +37,8 @@ func (c *Client) ListNodes(listOptions metav1.ListOptions) (*corev1.NodeList, er
 	})
 	return nodes, nil
 }
+
+// UpdateNode updates an already existing Node object.
+func (c *Client) UpdateNode(node *corev1.Node) (*corev1.Node, error) {
+	return c.clientset.CoreV1().Nodes().Update(node)
+}
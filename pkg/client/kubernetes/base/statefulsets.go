@@ -14,7 +14,19 @@
 
 package kubernetesbase
 
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
 // DeleteStatefulSet deletes a StatefulSet object.
 func (c *Client) DeleteStatefulSet(namespace, name string) error {
 	return c.Clientset().AppsV1beta2().StatefulSets(namespace).Delete(name, &defaultDeleteOptions)
 }
+
+// ScaleStatefulSet scales a StatefulSet object to the given number of <replicas>.
+func (c *Client) ScaleStatefulSet(namespace, name string, replicas int32) error {
+	_, err := c.Clientset().AppsV1beta2().StatefulSets(namespace).Patch(name, types.MergePatchType, []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)))
+	return err
+}
@@ -73,6 +73,7 @@ type Client interface {
 	CreateConfigMap(string, string, map[string]string, bool) (*corev1.ConfigMap, error)
 	UpdateConfigMap(string, string, map[string]string) (*corev1.ConfigMap, error)
 	GetConfigMap(string, string) (*corev1.ConfigMap, error)
+	ListConfigMaps(string, metav1.ListOptions) (*corev1.ConfigMapList, error)
 	DeleteConfigMap(string, string) error
 
 	// Services
@@ -82,9 +83,11 @@ type Client interface {
 	GetDeployment(string, string) (*mapping.Deployment, error)
 	ListDeployments(string, metav1.ListOptions) ([]*mapping.Deployment, error)
 	DeleteDeployment(string, string) error
+	ScaleDeployment(string, string, int32) error
 
 	// StatefulSets
 	DeleteStatefulSet(string, string) error
+	ScaleStatefulSet(string, string, int32) error
 
 	// Jobs
 	GetJob(string, string) (*batchv1.Job, error)
@@ -103,7 +106,9 @@ type Client interface {
 	DeletePod(string, string) error
 
 	// Nodes
+	GetNode(string) (*corev1.Node, error)
 	ListNodes(metav1.ListOptions) (*corev1.NodeList, error)
+	UpdateNode(*corev1.Node) (*corev1.Node, error)
 
 	// RoleBindings
 	ListRoleBindings(string, metav1.ListOptions) (*rbacv1.RoleBindingList, error)
@@ -15,10 +15,12 @@
 package kubernetesv19
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/gardener/gardener/pkg/client/kubernetes/mapping"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // GetDeployment returns a Deployment object.
@@ -50,3 +52,9 @@ func (c *Client) ListDeployments(namespace string, listOptions metav1.ListOption
 func (c *Client) DeleteDeployment(namespace, name string) error {
 	return c.Clientset().AppsV1().Deployments(namespace).Delete(name, &defaultDeleteOptions)
 }
+
+// ScaleDeployment scales a Deployment object to the given number of <replicas>.
+func (c *Client) ScaleDeployment(namespace, name string, replicas int32) error {
+	_, err := c.Clientset().AppsV1().Deployments(namespace).Patch(name, types.MergePatchType, []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)))
+	return err
+}
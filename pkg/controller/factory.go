@@ -33,6 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gardener/gardener/pkg/version"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -115,12 +116,20 @@ func (f *GardenControllerFactory) Run(stopCh <-chan struct{}) {
 		secretBindingController = secretbindingcontroller.NewSecretBindingController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, f.recorder)
 	)
 
-	go shootController.Run(f.config.Controllers.Shoot.ConcurrentSyncs, f.config.Controllers.ShootCare.ConcurrentSyncs, f.config.Controllers.ShootMaintenance.ConcurrentSyncs, f.config.Controllers.ShootQuota.ConcurrentSyncs, stopCh)
+	go shootController.Run(f.config.Controllers.Shoot.ConcurrentSyncs, f.config.Controllers.ShootCare.ConcurrentSyncs, f.config.Controllers.ShootHibernation.ConcurrentSyncs, f.config.Controllers.ShootMaintenance.ConcurrentSyncs, f.config.Controllers.ShootQuota.ConcurrentSyncs, stopCh)
 	go seedController.Run(f.config.Controllers.Seed.ConcurrentSyncs, stopCh)
 	go quotaController.Run(f.config.Controllers.Quota.ConcurrentSyncs, stopCh)
 	go cloudProfileController.Run(f.config.Controllers.CloudProfile.ConcurrentSyncs, stopCh)
 	go secretBindingController.Run(f.config.Controllers.SecretBinding.ConcurrentSyncs, stopCh)
 
+	if gardenMonitoring := f.config.Controllers.GardenMonitoring; gardenMonitoring != nil {
+		go wait.Until(func() {
+			if err := garden.DeployMonitoring(f.k8sGardenClient, f.k8sGardenInformers.Garden().V1beta1(), imageVector); err != nil {
+				logger.Logger.Errorf("Failed to deploy the garden monitoring: %s", err.Error())
+			}
+		}, gardenMonitoring.SyncPeriod.Duration, stopCh)
+	}
+
 	logger.Logger.Infof("Gardener controller manager (version %s) initialized.", version.Version)
 
 	// Shutdown handling
@@ -41,6 +41,10 @@ type Controller struct {
 	quotaSynced cache.InformerSynced
 
 	secretBindingLister gardenlisters.SecretBindingLister
+	secretBindingSynced cache.InformerSynced
+
+	shootLister gardenlisters.ShootLister
+	shootSynced cache.InformerSynced
 
 	workerCh               chan int
 	numberOfRunningWorkers int
@@ -53,19 +57,27 @@ func NewQuotaController(k8sGardenClient kubernetes.Client, gardenInformerFactory
 	var (
 		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
 
-		quotaInformer       = gardenv1beta1Informer.Quotas()
-		quotaLister         = quotaInformer.Lister()
-		secretBindingLister = gardenv1beta1Informer.SecretBindings().Lister()
+		quotaInformer = gardenv1beta1Informer.Quotas()
+		quotaLister   = quotaInformer.Lister()
+
+		secretBindingInformer = gardenv1beta1Informer.SecretBindings()
+		secretBindingLister   = secretBindingInformer.Lister()
+
+		shootInformer = gardenv1beta1Informer.Shoots()
+		shootLister   = shootInformer.Lister()
+
+		cloudProfileLister = gardenv1beta1Informer.CloudProfiles().Lister()
 	)
 
 	quotaController := &Controller{
 		k8sGardenClient:     k8sGardenClient,
 		k8sGardenInformers:  gardenInformerFactory,
-		control:             NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, secretBindingLister),
+		control:             NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, secretBindingLister, shootLister, cloudProfileLister),
 		recorder:            recorder,
 		quotaLister:         quotaLister,
 		quotaQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Quota"),
 		secretBindingLister: secretBindingLister,
+		shootLister:         shootLister,
 		workerCh:            make(chan int),
 	}
 
@@ -76,6 +88,20 @@ func NewQuotaController(k8sGardenClient kubernetes.Client, gardenInformerFactory
 	})
 	quotaController.quotaSynced = quotaInformer.Informer().HasSynced
 
+	secretBindingInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    quotaController.secretBindingAdd,
+		UpdateFunc: quotaController.secretBindingUpdate,
+		DeleteFunc: quotaController.secretBindingDelete,
+	})
+	quotaController.secretBindingSynced = secretBindingInformer.Informer().HasSynced
+
+	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    quotaController.shootAdd,
+		UpdateFunc: quotaController.shootUpdate,
+		DeleteFunc: quotaController.shootDelete,
+	})
+	quotaController.shootSynced = shootInformer.Informer().HasSynced
+
 	return quotaController
 }
 
@@ -83,7 +109,7 @@ func NewQuotaController(k8sGardenClient kubernetes.Client, gardenInformerFactory
 func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(stopCh, c.quotaSynced) {
+	if !cache.WaitForCacheSync(stopCh, c.quotaSynced, c.secretBindingSynced, c.shootSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
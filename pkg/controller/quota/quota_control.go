@@ -17,15 +17,22 @@ package quota
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	controllerutils "github.com/gardener/gardener/pkg/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -53,6 +60,69 @@ func (c *Controller) quotaDelete(obj interface{}) {
 	c.quotaQueue.Add(key)
 }
 
+func (c *Controller) secretBindingAdd(obj interface{}) {
+	secretBinding, ok := obj.(*gardenv1beta1.SecretBinding)
+	if !ok {
+		return
+	}
+	c.enqueueQuotas(secretBinding.Quotas)
+}
+
+func (c *Controller) secretBindingUpdate(oldObj, newObj interface{}) {
+	c.secretBindingAdd(oldObj)
+	c.secretBindingAdd(newObj)
+}
+
+func (c *Controller) secretBindingDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	c.secretBindingAdd(obj)
+}
+
+// shootAdd enqueues all Quotas which are referenced by the SecretBinding of the given Shoot as
+// their usage has to be recomputed.
+func (c *Controller) shootAdd(obj interface{}) {
+	shoot, ok := obj.(*gardenv1beta1.Shoot)
+	if !ok {
+		return
+	}
+
+	secretBinding, err := c.secretBindingLister.SecretBindings(shoot.Namespace).Get(shoot.Spec.Cloud.SecretBindingRef.Name)
+	if err != nil {
+		logger.Logger.Debugf("Couldn't get SecretBinding of Shoot %s/%s: %v", shoot.Namespace, shoot.Name, err)
+		return
+	}
+	c.enqueueQuotas(secretBinding.Quotas)
+}
+
+func (c *Controller) shootUpdate(oldObj, newObj interface{}) {
+	oldShoot, ok1 := oldObj.(*gardenv1beta1.Shoot)
+	newShoot, ok2 := newObj.(*gardenv1beta1.Shoot)
+	if !ok1 || !ok2 {
+		return
+	}
+
+	// The allocated resources only depend on the Shoot specification.
+	if oldShoot.Generation == newShoot.Generation {
+		return
+	}
+	c.shootAdd(newObj)
+}
+
+func (c *Controller) shootDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	c.shootAdd(obj)
+}
+
+func (c *Controller) enqueueQuotas(quotaRefs []corev1.ObjectReference) {
+	for _, quotaRef := range quotaRefs {
+		c.quotaQueue.Add(fmt.Sprintf("%s/%s", quotaRef.Namespace, quotaRef.Name))
+	}
+}
+
 func (c *Controller) reconcileQuotaKey(key string) error {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -90,8 +160,8 @@ type ControlInterface interface {
 // implements the documented semantics for Quotas. updater is the UpdaterInterface used
 // to update the status of Quotas. You should use an instance returned from NewDefaultControl() for any
 // scenario other than testing.
-func NewDefaultControl(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.SharedInformerFactory, recorder record.EventRecorder, secretBindingLister gardenlisters.SecretBindingLister) ControlInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenInformers, recorder, secretBindingLister}
+func NewDefaultControl(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.SharedInformerFactory, recorder record.EventRecorder, secretBindingLister gardenlisters.SecretBindingLister, shootLister gardenlisters.ShootLister, cloudProfileLister gardenlisters.CloudProfileLister) ControlInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenInformers, recorder, secretBindingLister, shootLister, cloudProfileLister}
 }
 
 type defaultControl struct {
@@ -99,6 +169,8 @@ type defaultControl struct {
 	k8sGardenInformers  gardeninformers.SharedInformerFactory
 	recorder            record.EventRecorder
 	secretBindingLister gardenlisters.SecretBindingLister
+	shootLister         gardenlisters.ShootLister
+	cloudProfileLister  gardenlisters.CloudProfileLister
 }
 
 func (c *defaultControl) ReconcileQuota(obj *gardenv1beta1.Quota, key string) error {
@@ -142,5 +214,112 @@ func (c *defaultControl) ReconcileQuota(obj *gardenv1beta1.Quota, key string) er
 		quotaLogger.Infof("Can't delete Quota, because the following SecretBindings are still referencing it: %v", associatedSecretBindings)
 		return errors.New("Quota still has references")
 	}
+
+	// Maintain the resources which are allocated by the Shoots consuming the Quota in its status. They are used
+	// by the ShootQuotaValidator admission plugin and show how much of the Quota is already used.
+	status, err := c.computeQuotaStatus(quota)
+	if err != nil {
+		quotaLogger.Error(err.Error())
+		return err
+	}
+	if apiequality.Semantic.DeepEqual(quota.Status, status) {
+		return nil
+	}
+
+	quota.Status = status
+	if _, err := c.k8sGardenClient.GardenClientset().GardenV1beta1().Quotas(quota.Namespace).UpdateStatus(quota); err != nil {
+		quotaLogger.Error(err.Error())
+		return err
+	}
 	return nil
 }
+
+// computeQuotaStatus determines the Shoots which consume the given <quota> (i.e., whose SecretBinding references
+// the Quota) and sums up the resources they allocate. The resources of every single Shoot are recorded as well so
+// that the ShootQuotaValidator admission plugin knows what has been booked for a Shoot which is updated.
+func (c *defaultControl) computeQuotaStatus(quota *gardenv1beta1.Quota) (gardenv1beta1.QuotaStatus, error) {
+	status := gardenv1beta1.QuotaStatus{
+		Used: make(corev1.ResourceList),
+	}
+	for _, metric := range helper.QuotaMetricNames {
+		status.Used[metric] = helper.SumQuantities()
+	}
+
+	secretBindings, err := c.secretBindingLister.List(labels.Everything())
+	if err != nil {
+		return status, err
+	}
+
+	for _, secretBinding := range secretBindings {
+		if !referencesQuota(secretBinding, quota) {
+			continue
+		}
+
+		shoots, err := c.shootLister.Shoots(secretBinding.Namespace).List(labels.Everything())
+		if err != nil {
+			return status, err
+		}
+
+		for _, shoot := range shoots {
+			if shoot.Spec.Cloud.SecretBindingRef.Name != secretBinding.Name {
+				continue
+			}
+
+			shootResources, err := c.computeShootResources(shoot)
+			if err != nil {
+				return status, fmt.Errorf("could not determine the resources allocated by Shoot %s/%s: %v", shoot.Namespace, shoot.Name, err)
+			}
+
+			shootUsage := gardenv1beta1.QuotaShootUsage{
+				Namespace: shoot.Namespace,
+				Name:      shoot.Name,
+				Used:      make(corev1.ResourceList),
+			}
+			for _, metric := range helper.QuotaMetricNames {
+				status.Used[metric] = helper.SumQuantities(status.Used[metric], shootResources[metric])
+				shootUsage.Used[metric] = helper.SumQuantities(shootResources[metric])
+			}
+			status.Shoots = append(status.Shoots, shootUsage)
+		}
+	}
+
+	sort.Slice(status.Shoots, func(i, j int) bool {
+		if status.Shoots[i].Namespace != status.Shoots[j].Namespace {
+			return status.Shoots[i].Namespace < status.Shoots[j].Namespace
+		}
+		return status.Shoots[i].Name < status.Shoots[j].Name
+	})
+
+	return status, nil
+}
+
+// computeShootResources converts the given <shoot> and its CloudProfile into their internal versions in order
+// to compute the resources it allocates in the same way as the ShootQuotaValidator admission plugin does.
+func (c *defaultControl) computeShootResources(shoot *gardenv1beta1.Shoot) (corev1.ResourceList, error) {
+	cloudProfile, err := c.cloudProfileLister.Get(shoot.Spec.Cloud.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		internalShoot        = &garden.Shoot{}
+		internalCloudProfile = &garden.CloudProfile{}
+	)
+	if err := api.Scheme.Convert(shoot, internalShoot, nil); err != nil {
+		return nil, err
+	}
+	if err := api.Scheme.Convert(cloudProfile, internalCloudProfile, nil); err != nil {
+		return nil, err
+	}
+
+	return helper.ComputeShootResources(*internalShoot, *internalCloudProfile)
+}
+
+func referencesQuota(secretBinding *gardenv1beta1.SecretBinding, quota *gardenv1beta1.Quota) bool {
+	for _, quotaRef := range secretBinding.Quotas {
+		if quotaRef.Namespace == quota.Namespace && quotaRef.Name == quota.Name {
+			return true
+		}
+	}
+	return false
+}
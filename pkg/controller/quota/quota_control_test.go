@@ -0,0 +1,210 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	. "github.com/gardener/gardener/pkg/controller/quota"
+	"github.com/gardener/gardener/pkg/logger"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("quota", func() {
+	var (
+		gardenInformerFactory gardeninformers.SharedInformerFactory
+		control               ControlInterface
+		quota                 *gardenv1beta1.Quota
+
+		cloudProfile = &gardenv1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "profile",
+			},
+			Spec: gardenv1beta1.CloudProfileSpec{
+				GCP: &gardenv1beta1.GCPProfile{
+					Constraints: gardenv1beta1.GCPConstraints{
+						MachineTypes: []gardenv1beta1.MachineType{
+							{
+								Name:   "n1-standard-2",
+								CPU:    resource.MustParse("2"),
+								GPU:    resource.MustParse("0"),
+								Memory: resource.MustParse("5Gi"),
+							},
+						},
+						VolumeTypes: []gardenv1beta1.VolumeType{
+							{
+								Name:  "pd-standard",
+								Class: "standard",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		newSecretBinding = func(namespace, name string, quotas ...corev1.ObjectReference) *gardenv1beta1.SecretBinding {
+			return &gardenv1beta1.SecretBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Quotas: quotas,
+			}
+		}
+
+		newShoot = func(namespace, name, secretBindingName string) *gardenv1beta1.Shoot {
+			return &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						Profile: cloudProfile.Name,
+						SecretBindingRef: corev1.LocalObjectReference{
+							Name: secretBindingName,
+						},
+						GCP: &gardenv1beta1.GCPCloud{
+							Workers: []gardenv1beta1.GCPWorker{
+								{
+									Worker: gardenv1beta1.Worker{
+										Name:          "worker",
+										MachineType:   "n1-standard-2",
+										AutoScalerMin: 1,
+										AutoScalerMax: 2,
+									},
+									VolumeType: "pd-standard",
+									VolumeSize: "20Gi",
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		add = func(obj interface{}) {
+			var err error
+			switch o := obj.(type) {
+			case *gardenv1beta1.CloudProfile:
+				err = gardenInformerFactory.Garden().V1beta1().CloudProfiles().Informer().GetStore().Add(o)
+			case *gardenv1beta1.SecretBinding:
+				err = gardenInformerFactory.Garden().V1beta1().SecretBindings().Informer().GetStore().Add(o)
+			case *gardenv1beta1.Shoot:
+				err = gardenInformerFactory.Garden().V1beta1().Shoots().Informer().GetStore().Add(o)
+			}
+			Expect(err).NotTo(HaveOccurred())
+		}
+	)
+
+	BeforeSuite(func() {
+		logger.Logger = logger.NewLogger("")
+	})
+
+	BeforeEach(func() {
+		quota = &gardenv1beta1.Quota{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "trial",
+				Name:      "trial-quota",
+			},
+		}
+
+		gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+		gardenInformers := gardenInformerFactory.Garden().V1beta1()
+		control = NewDefaultControl(nil, gardenInformerFactory, nil, gardenInformers.SecretBindings().Lister(), gardenInformers.Shoots().Lister(), gardenInformers.CloudProfiles().Lister())
+
+		add(cloudProfile)
+	})
+
+	Describe("#computeQuotaStatus", func() {
+		It("should sum up the resources of all Shoots consuming the Quota", func() {
+			quotaRef := corev1.ObjectReference{Namespace: quota.Namespace, Name: quota.Name}
+			add(newSecretBinding("project-b", "binding", quotaRef))
+			add(newSecretBinding("project-a", "binding", quotaRef))
+			add(newSecretBinding("project-a", "other-binding"))
+			add(newShoot("project-b", "shoot", "binding"))
+			add(newShoot("project-a", "shoot-2", "binding"))
+			add(newShoot("project-a", "shoot-1", "binding"))
+			add(newShoot("project-a", "other-shoot", "other-binding"))
+
+			status, err := ExportComputeQuotaStatus(control, quota)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Shoots).To(HaveLen(3))
+			for i, expected := range []corev1.ObjectReference{
+				{Namespace: "project-a", Name: "shoot-1"},
+				{Namespace: "project-a", Name: "shoot-2"},
+				{Namespace: "project-b", Name: "shoot"},
+			} {
+				Expect(status.Shoots[i].Namespace).To(Equal(expected.Namespace))
+				Expect(status.Shoots[i].Name).To(Equal(expected.Name))
+				used := status.Shoots[i].Used[garden.QuotaMetricCPU]
+				Expect(used.Cmp(resource.MustParse("4"))).To(BeZero(), "Shoot %s/%s uses %s CPUs instead of 4", expected.Namespace, expected.Name, used.String())
+			}
+			for metric, expected := range map[corev1.ResourceName]string{
+				garden.QuotaMetricCPU:             "12",
+				garden.QuotaMetricGPU:             "0",
+				garden.QuotaMetricMemory:          "30Gi",
+				garden.QuotaMetricCPUPreemptible:  "0",
+				garden.QuotaMetricStorageStandard: "120Gi",
+				garden.QuotaMetricStoragePremium:  "0",
+				garden.QuotaMetricLoadbalancer:    "3",
+			} {
+				used := status.Used[metric]
+				Expect(used.Cmp(resource.MustParse(expected))).To(BeZero(), "metric %s is %s instead of %s", metric, used.String(), expected)
+			}
+		})
+
+		It("should return an empty usage if no Shoot consumes the Quota", func() {
+			add(newSecretBinding("project-a", "binding", corev1.ObjectReference{Namespace: quota.Namespace, Name: quota.Name}))
+
+			status, err := ExportComputeQuotaStatus(control, quota)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Shoots).To(BeEmpty())
+			cpu := status.Used[garden.QuotaMetricCPU]
+			Expect(cpu.IsZero()).To(BeTrue())
+		})
+
+		It("should fail if the resources of a consuming Shoot cannot be determined", func() {
+			shoot := newShoot("project-a", "shoot", "binding")
+			shoot.Spec.Cloud.Profile = "unknown"
+			add(newSecretBinding("project-a", "binding", corev1.ObjectReference{Namespace: quota.Namespace, Name: quota.Name}))
+			add(shoot)
+
+			_, err := ExportComputeQuotaStatus(control, quota)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#ReconcileQuota", func() {
+		It("should fail so that the Quota is requeued if its usage cannot be determined", func() {
+			shoot := newShoot("project-a", "shoot", "binding")
+			shoot.Spec.Cloud.GCP.Workers[0].MachineType = "unknown"
+			add(newSecretBinding("project-a", "binding", corev1.ObjectReference{Namespace: quota.Namespace, Name: quota.Name}))
+			add(shoot)
+
+			err := control.ReconcileQuota(quota, "trial/trial-quota")
+
+			Expect(err).To(MatchError(ContainSubstring("could not determine the resources allocated by Shoot project-a/shoot")))
+		})
+	})
+})
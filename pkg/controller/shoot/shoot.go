@@ -15,6 +15,7 @@
 package shoot
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -40,6 +41,7 @@ type Controller struct {
 	config             *componentconfig.ControllerManagerConfiguration
 	control            ControlInterface
 	careControl        CareControlInterface
+	hibernationControl HibernationControlInterface
 	maintenanceControl MaintenanceControlInterface
 	quotaControl       QuotaControlInterface
 	recorder           record.EventRecorder
@@ -49,6 +51,7 @@ type Controller struct {
 	shootLister           gardenlisters.ShootLister
 	shootQueue            workqueue.RateLimitingInterface
 	shootCareQueue        workqueue.RateLimitingInterface
+	shootHibernationQueue workqueue.RateLimitingInterface
 	shootMaintenanceQueue workqueue.RateLimitingInterface
 	shootQuotaQueue       workqueue.RateLimitingInterface
 
@@ -58,6 +61,9 @@ type Controller struct {
 	secretBindingSynced cache.InformerSynced
 	quotaSynced         cache.InformerSynced
 
+	reconciliations     map[string]context.CancelFunc
+	reconciliationsLock sync.Mutex
+
 	numberOfRunningWorkers int
 	workerCh               chan int
 }
@@ -79,6 +85,7 @@ func NewShootController(k8sGardenClient kubernetes.Client, k8sGardenInformers ga
 		config:                config,
 		control:               NewDefaultControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, config, gardenNamespace, recorder, shootUpdater),
 		careControl:           NewDefaultCareControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, config, shootUpdater),
+		hibernationControl:    NewDefaultHibernationControl(config, recorder, shootUpdater),
 		maintenanceControl:    NewDefaultMaintenanceControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, recorder, shootUpdater),
 		quotaControl:          NewDefaultQuotaControl(k8sGardenClient, gardenv1beta1Informer),
 		recorder:              recorder,
@@ -87,8 +94,10 @@ func NewShootController(k8sGardenClient kubernetes.Client, k8sGardenInformers ga
 		shootLister:           shootLister,
 		shootQueue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot"),
 		shootCareQueue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-care"),
+		shootHibernationQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-hibernation"),
 		shootMaintenanceQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-maintenance"),
 		shootQuotaQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-quota"),
+		reconciliations:       make(map[string]context.CancelFunc),
 		workerCh:              make(chan int),
 	}
 
@@ -109,6 +118,14 @@ func NewShootController(k8sGardenClient kubernetes.Client, k8sGardenInformers ga
 		},
 	})
 
+	shootInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: shootController.shootNamespaceFilter,
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc:    shootController.shootHibernationAdd,
+			DeleteFunc: shootController.shootHibernationDelete,
+		},
+	})
+
 	shootInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: shootController.shootNamespaceFilter,
 		Handler: cache.ResourceEventHandlerFuncs{
@@ -135,7 +152,7 @@ func NewShootController(k8sGardenClient kubernetes.Client, k8sGardenInformers ga
 }
 
 // Run runs the Controller until the given stop channel can be read from.
-func (c *Controller) Run(shootWorkers, shootCareWorkers, shootMaintenanceWorkers, shootQuotaWorkers int, stopCh <-chan struct{}) {
+func (c *Controller) Run(shootWorkers, shootCareWorkers, shootHibernationWorkers, shootMaintenanceWorkers, shootQuotaWorkers int, stopCh <-chan struct{}) {
 	var (
 		watchNamespace = c.config.Controllers.Shoot.WatchNamespace
 		waitGroup      sync.WaitGroup
@@ -170,6 +187,9 @@ func (c *Controller) Run(shootWorkers, shootCareWorkers, shootMaintenanceWorkers
 	for i := 0; i < shootCareWorkers; i++ {
 		controllerutils.CreateWorker(c.shootCareQueue, "Shoot Care", c.reconcileShootCareKey, stopCh, &waitGroup, c.workerCh)
 	}
+	for i := 0; i < shootHibernationWorkers; i++ {
+		controllerutils.CreateWorker(c.shootHibernationQueue, "Shoot Hibernation", c.reconcileShootHibernationKey, stopCh, &waitGroup, c.workerCh)
+	}
 	for i := 0; i < shootMaintenanceWorkers; i++ {
 		controllerutils.CreateWorker(c.shootMaintenanceQueue, "Shoot Maintenance", c.reconcileShootMaintenanceKey, stopCh, &waitGroup, c.workerCh)
 	}
@@ -181,6 +201,7 @@ func (c *Controller) Run(shootWorkers, shootCareWorkers, shootMaintenanceWorkers
 	<-stopCh
 	c.shootQueue.ShutDown()
 	c.shootCareQueue.ShutDown()
+	c.shootHibernationQueue.ShutDown()
 	c.shootMaintenanceQueue.ShutDown()
 	c.shootQuotaQueue.ShutDown()
 
@@ -188,9 +209,10 @@ func (c *Controller) Run(shootWorkers, shootCareWorkers, shootMaintenanceWorkers
 		var (
 			shootQueueLength            = c.shootQueue.Len()
 			shootCareQueueLength        = c.shootCareQueue.Len()
+			shootHibernationQueueLength = c.shootHibernationQueue.Len()
 			shootMaintenanceQueueLength = c.shootMaintenanceQueue.Len()
 			shootQuotaQueueLength       = c.shootQuotaQueue.Len()
-			queueLengths                = shootQueueLength + shootCareQueueLength + shootMaintenanceQueueLength + shootQuotaQueueLength
+			queueLengths                = shootQueueLength + shootCareQueueLength + shootHibernationQueueLength + shootMaintenanceQueueLength + shootQuotaQueueLength
 		)
 		if queueLengths == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Info("No running Shoot worker and no items left in the queues. Terminated Shoot controller...")
@@ -115,10 +115,11 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 
 	// Initialize conditions based on the current status.
 	var (
-		newConditions                    = helper.NewConditions(shoot.Status.Conditions, gardenv1beta1.ShootControlPlaneHealthy, gardenv1beta1.ShootEveryNodeReady, gardenv1beta1.ShootSystemComponentsHealthy)
+		newConditions                    = helper.NewConditions(shoot.Status.Conditions, gardenv1beta1.ShootControlPlaneHealthy, gardenv1beta1.ShootEveryNodeReady, gardenv1beta1.ShootSystemComponentsHealthy, gardenv1beta1.ShootCertificatesValid)
 		conditionControlPlaneHealthy     = newConditions[0]
 		conditionEveryNodeReady          = newConditions[1]
 		conditionSystemComponentsHealthy = newConditions[2]
+		conditionCertificatesValid       = newConditions[3]
 	)
 
 	botanist, err := botanistpkg.New(operation)
@@ -127,8 +128,9 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 		conditionControlPlaneHealthy = helper.ModifyCondition(conditionControlPlaneHealthy, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
 		conditionEveryNodeReady = helper.ModifyCondition(conditionEveryNodeReady, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
 		conditionSystemComponentsHealthy = helper.ModifyCondition(conditionSystemComponentsHealthy, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
+		conditionCertificatesValid = helper.ModifyCondition(conditionCertificatesValid, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
 		operation.Logger.Error(message)
-		c.updateShootStatus(shoot, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy)
+		c.updateShootStatus(shoot, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy, *conditionCertificatesValid)
 		return nil
 	}
 	cloudBotanist, err := cloudbotanist.New(operation, common.CloudPurposeShoot)
@@ -137,27 +139,32 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 		conditionControlPlaneHealthy = helper.ModifyCondition(conditionControlPlaneHealthy, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
 		conditionEveryNodeReady = helper.ModifyCondition(conditionEveryNodeReady, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
 		conditionSystemComponentsHealthy = helper.ModifyCondition(conditionSystemComponentsHealthy, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
+		conditionCertificatesValid = helper.ModifyCondition(conditionCertificatesValid, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
 		operation.Logger.Error(message)
-		c.updateShootStatus(shoot, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy)
+		c.updateShootStatus(shoot, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy, *conditionCertificatesValid)
 		return nil
 	}
-	if err := botanist.InitializeShootClients(); err != nil {
-		message := fmt.Sprintf("Failed to create a K8SClient for the Shoot cluster to perform the care operations (%s).", err.Error())
-		conditionEveryNodeReady = helper.ModifyCondition(conditionEveryNodeReady, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
-		conditionSystemComponentsHealthy = helper.ModifyCondition(conditionSystemComponentsHealthy, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
-		operation.Logger.Error(message)
-		c.updateShootStatus(shoot, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy)
-		return nil
+	// The kube-apiserver of a hibernated Shoot cluster is (being) scaled down, hence, no connection is established.
+	// The health checks respect the hibernation and do not require the Shoot client.
+	if !operation.Shoot.Hibernated {
+		if err := botanist.InitializeShootClients(); err != nil {
+			message := fmt.Sprintf("Failed to create a K8SClient for the Shoot cluster to perform the care operations (%s).", err.Error())
+			conditionEveryNodeReady = helper.ModifyCondition(conditionEveryNodeReady, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
+			conditionSystemComponentsHealthy = helper.ModifyCondition(conditionSystemComponentsHealthy, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
+			operation.Logger.Error(message)
+			c.updateShootStatus(shoot, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy, *conditionCertificatesValid)
+			return nil
+		}
 	}
 
 	// Trigger garbage collection
 	garbageCollection(botanist)
 
 	// Trigger health check
-	conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy = healthCheck(botanist, cloudBotanist, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy)
+	conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy, conditionCertificatesValid = healthCheck(botanist, cloudBotanist, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy, conditionCertificatesValid)
 
 	// Update Shoot status
-	if newShoot, _ := c.updateShootStatus(shoot, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy); newShoot != nil {
+	if newShoot, _ := c.updateShootStatus(shoot, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy, *conditionCertificatesValid); newShoot != nil {
 		shoot = newShoot
 	}
 
@@ -165,7 +172,7 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 	var (
 		lastOperation = shoot.Status.LastOperation
 		lastError     = shoot.Status.LastError
-		healthy       = lastOperation == nil || (lastOperation.State == gardenv1beta1.ShootLastOperationStateSucceeded && lastError == nil && conditionControlPlaneHealthy.Status == corev1.ConditionTrue && conditionEveryNodeReady.Status == corev1.ConditionTrue && conditionSystemComponentsHealthy.Status == corev1.ConditionTrue)
+		healthy       = lastOperation == nil || (lastOperation.State == gardenv1beta1.ShootLastOperationStateSucceeded && lastError == nil && conditionControlPlaneHealthy.Status == corev1.ConditionTrue && conditionEveryNodeReady.Status == corev1.ConditionTrue && conditionSystemComponentsHealthy.Status == corev1.ConditionTrue && conditionCertificatesValid.Status != corev1.ConditionFalse)
 	)
 	c.labelShoot(shoot, healthy)
 
@@ -203,15 +210,19 @@ func (c *defaultCareControl) labelShoot(shoot *gardenv1beta1.Shoot, healthy bool
 func garbageCollection(botanist *botanistpkg.Botanist) {
 	var wg sync.WaitGroup
 
-	wg.Add(2)
+	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		botanist.PerformGarbageCollectionSeed()
 	}()
-	go func() {
-		defer wg.Done()
-		botanist.PerformGarbageCollectionShoot()
-	}()
+	// The Shoot cluster cannot be accessed if it has been hibernated.
+	if !botanist.Shoot.Hibernated {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			botanist.PerformGarbageCollectionShoot()
+		}()
+	}
 	wg.Wait()
 
 	botanist.Logger.Debugf("Successfully performed garbage collection for Shoot cluster '%s'", botanist.Shoot.Info.Name)
@@ -220,11 +231,12 @@ func garbageCollection(botanist *botanistpkg.Botanist) {
 // healthCheck performs several health checks and updates the status conditions.
 // It receives a Garden object <garden> which stores the Shoot object.
 // The current Health check verifies that the control plane running in the Seed cluster is healthy, every
-// node is ready and that all system components (pods running kube-system) are healthy.
-func healthCheck(botanist *botanistpkg.Botanist, cloudBotanist cloudbotanist.CloudBotanist, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy *gardenv1beta1.Condition) (*gardenv1beta1.Condition, *gardenv1beta1.Condition, *gardenv1beta1.Condition) {
+// node is ready, that all system components (pods running kube-system) are healthy, and that the certificates
+// of the Shoot cluster are valid.
+func healthCheck(botanist *botanistpkg.Botanist, cloudBotanist cloudbotanist.CloudBotanist, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy, conditionCertificatesValid *gardenv1beta1.Condition) (*gardenv1beta1.Condition, *gardenv1beta1.Condition, *gardenv1beta1.Condition, *gardenv1beta1.Condition) {
 	var wg sync.WaitGroup
 
-	wg.Add(3)
+	wg.Add(4)
 	go func() {
 		defer wg.Done()
 		conditionControlPlaneHealthy = botanist.CheckConditionControlPlaneHealthy(conditionControlPlaneHealthy)
@@ -237,8 +249,12 @@ func healthCheck(botanist *botanistpkg.Botanist, cloudBotanist cloudbotanist.Clo
 		defer wg.Done()
 		conditionSystemComponentsHealthy = botanist.CheckConditionSystemComponentsHealthy(conditionSystemComponentsHealthy)
 	}()
+	go func() {
+		defer wg.Done()
+		conditionCertificatesValid = botanist.CheckConditionCertificatesValid(conditionCertificatesValid)
+	}()
 	wg.Wait()
 
 	botanist.Logger.Debugf("Successfully performed health check for Shoot cluster '%s'", botanist.Shoot.Info.Name)
-	return conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy
+	return conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy, conditionCertificatesValid
 }
@@ -15,6 +15,7 @@
 package shoot
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"time"
@@ -56,6 +57,16 @@ func (c *Controller) shootUpdate(oldObj, newObj interface{}) {
 	shootLogger.Debugf(string(oldShootJSON))
 	shootLogger.Debugf(string(newShootJSON))
 
+	// If the Shoot has been marked for deletion while a reconciliation is still running, we cancel the running
+	// reconciliation so that the deletion can start without waiting for the reconciliation flow to drain.
+	if newShoot.DeletionTimestamp != nil && metav1.HasAnnotation(newShoot.ObjectMeta, common.ConfirmationDeletionTimestamp) && common.CheckConfirmationDeletionTimestampValid(newShoot.ObjectMeta) {
+		if key, err := cache.MetaNamespaceKeyFunc(newObj); err == nil && c.cancelShootReconciliation(key) {
+			shootLogger.Info("Cancelled the running reconciliation as the Shoot has been marked for deletion")
+			c.shootAdd(newObj)
+			return
+		}
+	}
+
 	// If the generation did not change for an update event (i.e., no changes to the .spec section have
 	// been made), we do not want to add the Shoot to th queue. The period reconciliation is handled
 	// elsewhere by adding the Shoot to the queue to dedicated times.
@@ -100,7 +111,14 @@ func (c *Controller) reconcileShootKey(key string) error {
 		return nil
 	}
 
-	needsRequeue, reconcileErr := c.control.ReconcileShoot(shoot, key)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if shoot.DeletionTimestamp == nil {
+		c.registerShootReconciliation(key, cancel)
+		defer c.unregisterShootReconciliation(key)
+	}
+
+	needsRequeue, reconcileErr := c.control.ReconcileShoot(ctx, shoot, key)
 
 	if wantsResync, durationToNextSync := scheduleNextSync(shoot.ObjectMeta, reconcileErr != nil, c.config.Controllers.Shoot); wantsResync && needsRequeue {
 		c.shootQueue.AddAfter(key, durationToNextSync)
@@ -110,6 +128,35 @@ func (c *Controller) reconcileShootKey(key string) error {
 	return nil
 }
 
+// registerShootReconciliation stores the <cancel> function of the running reconciliation of the Shoot with
+// the given <key>.
+func (c *Controller) registerShootReconciliation(key string, cancel context.CancelFunc) {
+	c.reconciliationsLock.Lock()
+	defer c.reconciliationsLock.Unlock()
+	c.reconciliations[key] = cancel
+}
+
+// unregisterShootReconciliation removes the cancel function of the reconciliation of the Shoot with the given <key>.
+func (c *Controller) unregisterShootReconciliation(key string) {
+	c.reconciliationsLock.Lock()
+	defer c.reconciliationsLock.Unlock()
+	delete(c.reconciliations, key)
+}
+
+// cancelShootReconciliation cancels the running reconciliation of the Shoot with the given <key>. It returns
+// true if a running reconciliation has been found, and false otherwise.
+func (c *Controller) cancelShootReconciliation(key string) bool {
+	c.reconciliationsLock.Lock()
+	defer c.reconciliationsLock.Unlock()
+
+	cancel, ok := c.reconciliations[key]
+	if ok {
+		cancel()
+		delete(c.reconciliations, key)
+	}
+	return ok
+}
+
 func scheduleNextSync(objectMeta metav1.ObjectMeta, errorOccured bool, config componentconfig.ShootControllerConfiguration) (bool, time.Duration) {
 	if errorOccured {
 		return true, (*config.RetrySyncPeriod).Duration
@@ -150,7 +197,8 @@ type ControlInterface interface {
 	// Implementors should sink any errors that they do not wish to trigger a retry, and they may feel free to
 	// exit exceptionally at any point provided they wish the update to be re-run at a later point in time.
 	// The bool return value determines whether the Shoot should be automatically requeued for reconciliation.
-	ReconcileShoot(shoot *gardenv1beta1.Shoot, key string) (bool, error)
+	// The operation is aborted as soon as the context <ctx> is cancelled.
+	ReconcileShoot(ctx context.Context, shoot *gardenv1beta1.Shoot, key string) (bool, error)
 }
 
 // NewDefaultControl returns a new instance of the default implementation ControlInterface that
@@ -173,7 +221,7 @@ type defaultControl struct {
 	updater            UpdaterInterface
 }
 
-func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key string) (bool, error) {
+func (c *defaultControl) ReconcileShoot(ctx context.Context, shootObj *gardenv1beta1.Shoot, key string) (bool, error) {
 	key, err := cache.MetaNamespaceKeyFunc(shootObj)
 	if err != nil {
 		return true, err
@@ -184,7 +232,7 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 		operationID   = utils.GenerateRandomString(8)
 		shootLogger   = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, operationID)
 		lastOperation = shoot.Status.LastOperation
-		operationType = computeOperationType(lastOperation)
+		operationType = computeOperationType(shoot)
 	)
 
 	logger.Logger.Infof("[SHOOT RECONCILE] %s", key)
@@ -221,7 +269,7 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 			shootLogger.Errorf("Could not update the Shoot status after deletion start: %+v", updateErr)
 			return true, updateErr
 		}
-		if deleteErr := c.deleteShoot(operation); deleteErr != nil {
+		if deleteErr := c.deleteShoot(ctx, operation); deleteErr != nil {
 			c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventDeleteError, "[%s] %s", operationID, deleteErr.Description)
 			if state, updateErr := c.updateShootStatusDeleteError(operation, deleteErr); updateErr != nil {
 				shootLogger.Errorf("Could not update the Shoot status after deletion error: %+v", updateErr)
@@ -243,7 +291,11 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 		shootLogger.Errorf("Could not update the Shoot status after reconciliation start: %+v", updateErr)
 		return true, updateErr
 	}
-	if reconcileErr := c.reconcileShoot(operation, operationType); reconcileErr != nil {
+	reconcileFunc := c.reconcileShoot
+	if operationType == gardenv1beta1.ShootLastOperationTypeMigrate {
+		reconcileFunc = c.migrateShoot
+	}
+	if reconcileErr := reconcileFunc(ctx, operation, operationType); reconcileErr != nil {
 		c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventReconcileError, "[%s] %s", operationID, reconcileErr.Description)
 		if state, updateErr := c.updateShootStatusReconcileError(operation, operationType, reconcileErr); updateErr != nil {
 			shootLogger.Errorf("Could not update the Shoot status after reconciliation error: %+v", updateErr)
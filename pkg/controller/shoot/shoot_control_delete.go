@@ -15,6 +15,7 @@
 package shoot
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -35,8 +36,9 @@ import (
 )
 
 // deleteShoot deletes a Shoot cluster entirely.
-// It receives a Garden object <garden> which stores the Shoot object.
-func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.LastError {
+// It receives a Garden object <garden> which stores the Shoot object. The deletion is aborted as soon as
+// the context <ctx> is cancelled.
+func (c *defaultControl) deleteShoot(ctx context.Context, o *operation.Operation) *gardenv1beta1.LastError {
 	// If the .status.uid field is empty, then we assume that there has never been any operation running for this Shoot
 	// cluster. This implies that there can not be any resource which we have to delete. We accept the deletion.
 	if len(o.Shoot.Info.Status.UID) == 0 {
@@ -74,6 +76,20 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.Last
 		return formatError("Failed to create a HybridBotanist", err)
 	}
 
+	// If the control plane of a hibernated Shoot has been scaled down then it must be woken up again so that the
+	// resources within the Shoot cluster can be cleaned up properly.
+	if namespace.Status.Phase != corev1.NamespaceTerminating {
+		controlPlaneHibernated, err := botanist.IsControlPlaneHibernated()
+		if err != nil {
+			return formatError("Failed to determine whether the control plane is hibernated", err)
+		}
+		if controlPlaneHibernated {
+			if err := botanist.WakeUpControlPlane(ctx); err != nil {
+				return formatError("Failed to wake up the control plane of the hibernated Shoot cluster", err)
+			}
+		}
+	}
+
 	// We check whether the Shoot namespace in the Seed cluster is already in a terminating state, i.e. whether
 	// we have tried to delete it in a previous run. In that case, we do not need to cleanup Shoot resource because
 	// that would have already been done.
@@ -100,32 +116,32 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.Last
 		cleanupRetry          = 2 * time.Minute
 		isCloud               = o.Shoot.Info.Spec.Cloud.Vagrant == nil
 
-		f                                = flow.New("Shoot cluster deletion").SetProgressReporter(o.ReportShootProgress).SetLogger(o.Logger)
-		initializeShootClients           = f.AddTaskConditional(botanist.InitializeShootClients, 2*time.Minute, cleanupShootResources)
-		deleteSeedMonitoring             = f.AddTask(botanist.DeleteSeedMonitoring, defaultRetry, initializeShootClients)
-		deleteKubeAddonManager           = f.AddTask(botanist.DeleteKubeAddonManager, defaultRetry, initializeShootClients)
-		waitUntilKubeAddonManagerDeleted = f.AddTask(botanist.WaitUntilKubeAddonManagerDeleted, 0, deleteKubeAddonManager)
+		f                                = flow.New("Shoot cluster deletion").SetProgressReporter(o.ReportShootProgress).SetLogger(o.Logger).SetErrorPolicy(flow.ErrorPolicyContinueIndependent)
+		initializeShootClients           = f.AddTaskConditional("Initializing connection to Shoot", botanist.InitializeShootClients, 2*time.Minute, cleanupShootResources)
+		deleteSeedMonitoring             = f.AddTask("Deleting Shoot monitoring", botanist.DeleteSeedMonitoring, defaultRetry, initializeShootClients)
+		deleteKubeAddonManager           = f.AddTask("Deleting Kubernetes addon manager", botanist.DeleteKubeAddonManager, defaultRetry, initializeShootClients)
+		waitUntilKubeAddonManagerDeleted = f.AddTaskWithContext("Waiting until Kubernetes addon manager has been deleted", botanist.WaitUntilKubeAddonManagerDeleted, 0, deleteKubeAddonManager)
 		// We need to clean up the cluster resources which may have footprints in the infrastructure (such as
 		// LoadBalancers, volumes, ...). We do that by deleting all namespaces other than the three standard
 		// namespaces which cannot be deleted (kube-system, default, kube-public). In those three namespaces
 		// we delete all TPR/CRD data, workload, services and PVCs. Only if none of those resources remain, we
 		// go ahead and trigger the infrastructure deletion.
-		cleanKubernetesResources            = f.AddTaskConditional(botanist.CleanKubernetesResources, defaultRetry, cleanupShootResources, waitUntilKubeAddonManagerDeleted)
-		waitUntilKubernetesResourcesCleaned = f.AddTaskConditional(botanist.WaitUntilKubernetesResourcesCleaned, cleanupRetry, cleanupShootResources, cleanKubernetesResources)
-		destroyMachines                     = f.AddTaskConditional(hybridBotanist.DestroyMachines, defaultRetry, isCloud, waitUntilKubernetesResourcesCleaned)
-		destroyNginxIngressResources        = f.AddTask(botanist.DestroyNginxIngressResources, 0, waitUntilKubernetesResourcesCleaned)
-		destroyKube2IAMResources            = f.AddTask(shootCloudBotanist.DestroyKube2IAMResources, 0, waitUntilKubernetesResourcesCleaned)
-		destroyInfrastructure               = f.AddTask(shootCloudBotanist.DestroyInfrastructure, 0, waitUntilKubernetesResourcesCleaned, destroyMachines)
-		destroyExternalDomainDNSRecord      = f.AddTask(botanist.DestroyExternalDomainDNSRecord, 0, waitUntilKubernetesResourcesCleaned)
-		destroyBackupInfrastructure         = f.AddTask(seedCloudBotanist.DestroyBackupInfrastructure, 0, waitUntilKubernetesResourcesCleaned)
-		syncPointTerraformers               = f.AddSyncPoint(deleteSeedMonitoring, destroyNginxIngressResources, destroyKube2IAMResources, destroyInfrastructure, destroyExternalDomainDNSRecord, destroyBackupInfrastructure)
-		deleteKubeAPIServer                 = f.AddTask(botanist.DeleteKubeAPIServer, defaultRetry, syncPointTerraformers)
-		destroyInternalDomainDNSRecord      = f.AddTask(botanist.DestroyInternalDomainDNSRecord, 0, syncPointTerraformers)
-		deleteNamespace                     = f.AddTask(botanist.DeleteNamespace, defaultRetry, syncPointTerraformers, destroyInternalDomainDNSRecord, deleteKubeAPIServer)
-		_                                   = f.AddTask(botanist.WaitUntilNamespaceDeleted, 0, deleteNamespace)
-		_                                   = f.AddTask(botanist.DeleteGardenSecrets, defaultRetry, deleteNamespace)
+		cleanKubernetesResources            = f.AddTaskConditional("Cleaning Kubernetes resources", botanist.CleanKubernetesResources, defaultRetry, cleanupShootResources, waitUntilKubeAddonManagerDeleted)
+		waitUntilKubernetesResourcesCleaned = f.AddTaskConditional("Waiting until Kubernetes resources have been cleaned", botanist.WaitUntilKubernetesResourcesCleaned, cleanupRetry, cleanupShootResources, cleanKubernetesResources)
+		destroyMachines                     = f.AddTaskConditional("Destroying Shoot workers", hybridBotanist.DestroyMachines, defaultRetry, isCloud, waitUntilKubernetesResourcesCleaned)
+		destroyNginxIngressResources        = f.AddTask("Destroying nginx ingress resources", botanist.DestroyNginxIngressResources, 0, waitUntilKubernetesResourcesCleaned)
+		destroyKube2IAMResources            = f.AddTask("Destroying Kube2IAM resources", shootCloudBotanist.DestroyKube2IAMResources, 0, waitUntilKubernetesResourcesCleaned)
+		destroyInfrastructure               = f.AddTask("Destroying Shoot infrastructure", shootCloudBotanist.DestroyInfrastructure, 0, waitUntilKubernetesResourcesCleaned, destroyMachines)
+		destroyExternalDomainDNSRecord      = f.AddTask("Destroying external domain DNS record", botanist.DestroyExternalDomainDNSRecord, 0, waitUntilKubernetesResourcesCleaned)
+		destroyBackupInfrastructure         = f.AddTask("Destroying backup infrastructure", seedCloudBotanist.DestroyBackupInfrastructure, 0, waitUntilKubernetesResourcesCleaned)
+		syncPointTerraformers               = f.AddSyncPoint("Synchronizing Terraform destroy operations", deleteSeedMonitoring, destroyNginxIngressResources, destroyKube2IAMResources, destroyInfrastructure, destroyExternalDomainDNSRecord, destroyBackupInfrastructure)
+		deleteKubeAPIServer                 = f.AddTask("Deleting Kubernetes API server", botanist.DeleteKubeAPIServer, defaultRetry, syncPointTerraformers)
+		destroyInternalDomainDNSRecord      = f.AddTask("Destroying internal domain DNS record", botanist.DestroyInternalDomainDNSRecord, 0, syncPointTerraformers)
+		deleteNamespace                     = f.AddTask("Deleting Shoot namespace in Seed", botanist.DeleteNamespace, defaultRetry, syncPointTerraformers, destroyInternalDomainDNSRecord, deleteKubeAPIServer)
+		_                                   = f.AddTaskWithContext("Waiting until Shoot namespace in Seed has been deleted", botanist.WaitUntilNamespaceDeleted, 0, deleteNamespace)
+		_                                   = f.AddTask("Deleting Garden secrets", botanist.DeleteGardenSecrets, defaultRetry, deleteNamespace)
 	)
-	if e := f.Execute(); e != nil {
+	if e := f.Execute(ctx); e != nil {
 		e.Description = fmt.Sprintf("Failed to delete Shoot cluster: %s", e.Description)
 		return e
 	}
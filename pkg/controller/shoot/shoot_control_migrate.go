@@ -0,0 +1,151 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	hybridbotanistpkg "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// migrateShoot migrates the control plane of the Shoot cluster from the Seed cluster it is currently hosted on (stored
+// in its status) to the Seed cluster referenced in its specification. The control plane in the source Seed cluster is
+// stopped, a snapshot of etcd is taken, and the namespace content is copied to the target Seed cluster. Afterwards, the
+// Shoot is reconciled in the target Seed cluster (which restores etcd from the snapshot and switches the DNS records),
+// and finally the namespace in the source Seed cluster is deleted. The name of the snapshot is recorded on the source
+// namespace once its content has been transferred so that later attempts do not transfer it again.
+func (c *defaultControl) migrateShoot(ctx context.Context, o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType) *gardenv1beta1.LastError {
+	sourceShoot := o.Shoot.Info.DeepCopy()
+	sourceShoot.Spec.Cloud.Seed = sourceShoot.Status.Seed
+	sourceOperation, err := operation.New(sourceShoot, o.Logger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector)
+	if err != nil {
+		return formatError("Failed to initialize the operation for the source Seed", err)
+	}
+
+	// We create the botanists (which will do the actual work).
+	botanist, err := botanistpkg.New(o)
+	if err != nil {
+		return formatError("Failed to create a Botanist", err)
+	}
+	shootCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeShoot)
+	if err != nil {
+		return formatError("Failed to create a Shoot CloudBotanist", err)
+	}
+	sourceBotanist, err := botanistpkg.New(sourceOperation)
+	if err != nil {
+		return formatError("Failed to create a Botanist for the source Seed", err)
+	}
+	sourceSeedCloudBotanist, err := cloudbotanistpkg.New(sourceOperation, common.CloudPurposeSeed)
+	if err != nil {
+		return formatError("Failed to create a Seed CloudBotanist for the source Seed", err)
+	}
+	sourceShootCloudBotanist, err := cloudbotanistpkg.New(sourceOperation, common.CloudPurposeShoot)
+	if err != nil {
+		return formatError("Failed to create a Shoot CloudBotanist for the source Seed", err)
+	}
+	sourceHybridBotanist, err := hybridbotanistpkg.New(sourceOperation, sourceBotanist, sourceSeedCloudBotanist, sourceShootCloudBotanist)
+	if err != nil {
+		return formatError("Failed to create a HybridBotanist for the source Seed", err)
+	}
+
+	// The namespace in the source Seed cluster is only deleted after the control plane has been successfully reconciled
+	// in the target Seed cluster. In this case, only the cleanup of the source Seed cluster is left.
+	sourceNamespace, err := sourceBotanist.K8sSeedClient.GetNamespace(sourceBotanist.Shoot.SeedNamespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return formatError("Failed to retrieve the Shoot namespace in the source Seed", err)
+	}
+	controlPlaneMoved := apierrors.IsNotFound(err) || sourceNamespace.DeletionTimestamp != nil
+
+	// The content of the source namespace is transferred only once. A previous attempt might have failed after the
+	// control plane in the target Seed cluster has been started, hence, a new snapshot of the stale source etcd and a
+	// new copy of the source namespace would overwrite the state of the target Seed cluster.
+	snapshotName := fmt.Sprintf("migration-%d", o.Shoot.Info.Generation)
+	namespaceTransferred := false
+	if !controlPlaneMoved {
+		if snapshot, ok := sourceNamespace.Annotations[common.ShootMigrationSnapshot]; ok {
+			snapshotName, namespaceTransferred = snapshot, true
+		}
+	}
+	transferNamespace := !controlPlaneMoved && !namespaceTransferred
+
+	var (
+		_, machineClassPlural, _ = shootCloudBotanist.GetMachineClassInfo()
+
+		snapshotSourceEtcd = func(ctx context.Context) error {
+			return sourceHybridBotanist.SnapshotEtcd(ctx, snapshotName)
+		}
+		copySourceNamespace = func() error {
+			return botanist.CopySeedNamespace(sourceBotanist)
+		}
+		migrateMachineResources = func() error {
+			return botanist.MigrateMachineResources(sourceBotanist, machineClassPlural)
+		}
+		recordMigrationSnapshot = func() error {
+			return sourceBotanist.RecordMigrationSnapshot(snapshotName)
+		}
+	)
+
+	var (
+		defaultRetry = 30 * time.Second
+		isCloud      = o.Shoot.Info.Spec.Cloud.Vagrant == nil
+
+		f                      = flow.New("Shoot cluster control plane migration").SetProgressReporter(o.ReportShootProgress).SetLogger(o.Logger).SetErrorPolicy(flow.ErrorPolicyFailFast)
+		stopSourceControlPlane = f.AddTaskConditional("Stopping control plane in source Seed", sourceBotanist.StopControlPlane, defaultRetry, transferNamespace)
+		snapshotEtcd           = f.AddTaskConditionalWithContext("Taking etcd snapshot in source Seed", snapshotSourceEtcd, defaultRetry, transferNamespace, stopSourceControlPlane)
+		deployNamespace        = f.AddTaskConditional("Deploying Shoot namespace in target Seed", botanist.DeployNamespace, defaultRetry, transferNamespace)
+		copySeedNamespace      = f.AddTaskConditional("Copying certificates and Terraform states to target Seed", copySourceNamespace, defaultRetry, transferNamespace, stopSourceControlPlane, deployNamespace)
+		migrateMachines        = f.AddTaskConditional("Migrating machine resources to target Seed", migrateMachineResources, defaultRetry, isCloud && transferNamespace, copySeedNamespace)
+		_                      = f.AddTaskConditional("Recording etcd snapshot in source Seed", recordMigrationSnapshot, defaultRetry, transferNamespace, snapshotEtcd, copySeedNamespace, migrateMachines)
+	)
+
+	if e := f.Execute(ctx); e != nil {
+		e.Description = fmt.Sprintf("Failed to migrate Shoot cluster control plane: %s", e.Description)
+		return e
+	}
+
+	// The Shoot is reconciled in the target Seed cluster with the etcd being restored from the snapshot taken above. The
+	// restoration is identified by the name of the snapshot, hence, it is not repeated by later attempts (even if the
+	// generation of the Shoot has changed in the meantime).
+	if !controlPlaneMoved {
+		o.Shoot.EtcdRestoreSnapshot = snapshotName
+		o.Shoot.EtcdRestoreID = snapshotName
+		if e := c.reconcileShoot(ctx, o, operationType); e != nil {
+			return e
+		}
+	}
+
+	var (
+		cleanupFlow           = flow.New("Shoot cluster control plane migration cleanup").SetProgressReporter(o.ReportShootProgress).SetLogger(o.Logger).SetErrorPolicy(flow.ErrorPolicyFailFast)
+		deleteSourceNamespace = cleanupFlow.AddTask("Deleting Shoot namespace in source Seed", sourceBotanist.DeleteNamespace, defaultRetry)
+		_                     = cleanupFlow.AddTaskWithContext("Waiting until Shoot namespace in source Seed has been deleted", sourceBotanist.WaitUntilNamespaceDeleted, 0, deleteSourceNamespace)
+	)
+
+	if e := cleanupFlow.Execute(ctx); e != nil {
+		e.Description = fmt.Sprintf("Failed to clean up the source Seed after the control plane migration: %s", e.Description)
+		return e
+	}
+
+	o.Logger.Infof("Successfully migrated Shoot cluster control plane '%s' to Seed '%s'", o.Shoot.Info.Name, *o.Shoot.Info.Spec.Cloud.Seed)
+	return nil
+}
@@ -15,6 +15,7 @@
 package shoot
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -27,12 +28,26 @@ import (
 	hybridbotanistpkg "github.com/gardener/gardener/pkg/operation/hybridbotanist"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // reconcileShoot reconciles the Shoot cluster's state.
-// It receives a Garden object <garden> which stores the Shoot object and the operation type.
-func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType) *gardenv1beta1.LastError {
+// It receives a Garden object <garden> which stores the Shoot object and the operation type. The reconciliation
+// is aborted as soon as the context <ctx> is cancelled.
+func (c *defaultControl) reconcileShoot(ctx context.Context, o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType) *gardenv1beta1.LastError {
+	certificateSettings, err := computeCertificateSettings(o.Shoot.CertificateSettings, c.config.Controllers.Shoot.Certificates, o.Shoot.Info)
+	if err != nil {
+		return formatError("Failed to compute the certificate settings", err)
+	}
+	o.Shoot.CertificateSettings = certificateSettings
+
+	// Leaf certificates which are about to expire are regenerated automatically if a renewal threshold is configured.
+	if o.Shoot.CertificateRotation == "" && mustRenewCertificates(o.Shoot.Info, c.config.Controllers.Shoot.CertificateRenewalThreshold) {
+		o.Logger.Info("Certificates of the Shoot cluster are about to expire, they will be regenerated")
+		o.Shoot.CertificateRotation = common.CertificateRotationLeaves
+	}
+
 	// We create the botanists (which will do the actual work).
 	botanist, err := botanistpkg.New(o)
 	if err != nil {
@@ -51,42 +66,96 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 		return formatError("Failed to create a HybridBotanist", err)
 	}
 
+	// If the control plane of a hibernated Shoot has already been scaled down then all the tasks which require a
+	// running kube-apiserver are skipped. They will be executed again as soon as the Shoot is woken up.
+	controlPlaneHibernated, err := botanist.IsControlPlaneHibernated()
+	if err != nil {
+		return formatError("Failed to determine whether the control plane is hibernated", err)
+	}
+
 	var (
-		defaultRetry = 30 * time.Second
-		managedDNS   = o.Shoot.Info.Spec.DNS.Provider != gardenv1beta1.DNSUnmanaged
-		isCloud      = o.Shoot.Info.Spec.Cloud.Vagrant == nil
-
-		f                                    = flow.New("Shoot cluster creation").SetProgressReporter(o.ReportShootProgress).SetLogger(o.Logger)
-		deployNamespace                      = f.AddTask(botanist.DeployNamespace, defaultRetry)
-		deployKubeAPIServerService           = f.AddTask(botanist.DeployKubeAPIServerService, defaultRetry, deployNamespace)
-		waitUntilKubeAPIServerServiceIsReady = f.AddTaskConditional(botanist.WaitUntilKubeAPIServerServiceIsReady, 0, isCloud, deployKubeAPIServerService)
-		deploySecrets                        = f.AddTask(botanist.DeploySecrets, 0, waitUntilKubeAPIServerServiceIsReady)
-		_                                    = f.AddTask(botanist.DeployInternalDomainDNSRecord, 0, waitUntilKubeAPIServerServiceIsReady)
-		_                                    = f.AddTaskConditional(botanist.DeployExternalDomainDNSRecord, 0, managedDNS)
-		deployInfrastructure                 = f.AddTask(shootCloudBotanist.DeployInfrastructure, 0, deploySecrets)
-		deployBackupInfrastructure           = f.AddTaskConditional(seedCloudBotanist.DeployBackupInfrastructure, 0, isCloud, deployNamespace)
-		deployETCD                           = f.AddTask(hybridBotanist.DeployETCD, defaultRetry, deployBackupInfrastructure)
-		deployCloudProviderConfig            = f.AddTask(hybridBotanist.DeployCloudProviderConfig, defaultRetry, deployInfrastructure)
-		deployKubeAPIServer                  = f.AddTask(hybridBotanist.DeployKubeAPIServer, defaultRetry, deploySecrets, deployETCD, waitUntilKubeAPIServerServiceIsReady, deployCloudProviderConfig)
-		_                                    = f.AddTask(hybridBotanist.DeployKubeControllerManager, defaultRetry, deployCloudProviderConfig, deployKubeAPIServer)
-		_                                    = f.AddTask(hybridBotanist.DeployKubeScheduler, defaultRetry, deployKubeAPIServer)
-		waitUntilKubeAPIServerIsReady        = f.AddTask(botanist.WaitUntilKubeAPIServerIsReady, 0, deployKubeAPIServer)
-		initializeShootClients               = f.AddTask(botanist.InitializeShootClients, 2*time.Minute, waitUntilKubeAPIServerIsReady)
-		deployMachineControllerManager       = f.AddTaskConditional(botanist.DeployMachineControllerManager, defaultRetry, isCloud, initializeShootClients)
-		deployMachines                       = f.AddTaskConditional(hybridBotanist.DeployMachines, defaultRetry, isCloud, deployMachineControllerManager, deployInfrastructure, initializeShootClients)
-		deployKubeAddonManager               = f.AddTask(hybridBotanist.DeployKubeAddonManager, defaultRetry, initializeShootClients, deployInfrastructure)
-		_                                    = f.AddTask(shootCloudBotanist.DeployKube2IAMResources, defaultRetry, deployInfrastructure)
-		_                                    = f.AddTaskConditional(botanist.DeployNginxIngressResources, 10*time.Minute, managedDNS, deployKubeAddonManager)
-		waitUntilVPNConnectionExists         = f.AddTaskConditional(botanist.WaitUntilVPNConnectionExists, 0, !o.Shoot.Hibernated, deployKubeAddonManager, deployMachines)
-		applyCreateHook                      = f.AddTask(seedCloudBotanist.ApplyCreateHook, defaultRetry, waitUntilVPNConnectionExists)
-		_                                    = f.AddTask(botanist.DeploySeedMonitoring, defaultRetry, waitUntilKubeAPIServerIsReady, initializeShootClients, waitUntilVPNConnectionExists, deployMachines, applyCreateHook)
+		defaultRetry        = 30 * time.Second
+		managedDNS          = o.Shoot.Info.Spec.DNS.Provider != gardenv1beta1.DNSUnmanaged
+		isCloud             = o.Shoot.Info.Spec.Cloud.Vagrant == nil
+		controlPlaneRunning = !controlPlaneHibernated
+
+		flowName                             = "Shoot cluster creation"
+		f                                    = flow.New(flowName).SetProgressReporter(o.ReportShootProgress).SetStateRecorder(o.ReportFlowState).SetLogger(o.Logger).SetErrorPolicy(flow.ErrorPolicyFailFast).Resume(o.GetFlowState(flowName))
+		deployNamespace                      = f.AddTask("Deploying Shoot namespace in Seed", botanist.DeployNamespace, defaultRetry)
+		deployKubeAPIServerService           = f.AddTask("Deploying Kubernetes API server service", botanist.DeployKubeAPIServerService, defaultRetry, deployNamespace)
+		waitUntilKubeAPIServerServiceIsReady = f.AddTaskConditionalWithContext("Waiting until Kubernetes API server service is ready", botanist.WaitUntilKubeAPIServerServiceIsReady, 0, isCloud, deployKubeAPIServerService)
+		deploySecrets                        = f.AddTask("Deploying Shoot certificates and keys", botanist.DeploySecrets, 0, waitUntilKubeAPIServerServiceIsReady)
+		_                                    = f.AddTask("Deploying internal domain DNS record", botanist.DeployInternalDomainDNSRecord, 0, waitUntilKubeAPIServerServiceIsReady).SetResumable()
+		_                                    = f.AddTaskConditional("Deploying external domain DNS record", botanist.DeployExternalDomainDNSRecord, 0, managedDNS).SetResumable()
+		deployInfrastructure                 = f.AddTask("Deploying Shoot infrastructure", shootCloudBotanist.DeployInfrastructure, 0, deploySecrets).SetResumable()
+		deployBackupInfrastructure           = f.AddTaskConditional("Deploying backup infrastructure", seedCloudBotanist.DeployBackupInfrastructure, 0, isCloud, deployNamespace).SetResumable()
+		deployETCD                           = f.AddTaskConditionalWithContext("Deploying main and events etcd", hybridBotanist.DeployETCD, defaultRetry, controlPlaneRunning, deployBackupInfrastructure)
+		deployCloudProviderConfig            = f.AddTask("Deploying cloud provider configuration", hybridBotanist.DeployCloudProviderConfig, defaultRetry, deployInfrastructure)
+		deployKubeAPIServer                  = f.AddTaskConditional("Deploying Kubernetes API server", hybridBotanist.DeployKubeAPIServer, defaultRetry, controlPlaneRunning, deploySecrets, deployETCD, waitUntilKubeAPIServerServiceIsReady, deployCloudProviderConfig)
+		waitUntilKubeAPIServerIsReady        = f.AddTaskConditionalWithContext("Waiting until Kubernetes API server is ready", botanist.WaitUntilKubeAPIServerIsReady, 0, controlPlaneRunning, deployKubeAPIServer)
+		deployKubeControllerManager          = f.AddTaskConditional("Deploying Kubernetes controller manager", hybridBotanist.DeployKubeControllerManager, defaultRetry, controlPlaneRunning, deployCloudProviderConfig, waitUntilKubeAPIServerIsReady)
+		deployKubeScheduler                  = f.AddTaskConditional("Deploying Kubernetes scheduler", hybridBotanist.DeployKubeScheduler, defaultRetry, controlPlaneRunning, waitUntilKubeAPIServerIsReady)
+		initializeShootClients               = f.AddTaskConditional("Initializing connection to Shoot", botanist.InitializeShootClients, 2*time.Minute, controlPlaneRunning, waitUntilKubeAPIServerIsReady)
+		deployMachineControllerManager       = f.AddTaskConditional("Deploying machine controller manager", botanist.DeployMachineControllerManager, defaultRetry, isCloud && controlPlaneRunning, initializeShootClients)
+		deployMachines                       = f.AddTaskConditional("Deploying Shoot workers", hybridBotanist.DeployMachines, defaultRetry, isCloud && controlPlaneRunning, deployMachineControllerManager, deployInfrastructure, initializeShootClients)
+		deployKubeAddonManager               = f.AddTaskConditional("Deploying Kubernetes addon manager", hybridBotanist.DeployKubeAddonManager, defaultRetry, controlPlaneRunning, initializeShootClients, deployInfrastructure)
+		_                                    = f.AddTask("Deploying Kube2IAM resources", shootCloudBotanist.DeployKube2IAMResources, defaultRetry, deployInfrastructure).SetResumable()
+		deployNginxIngressResources          = f.AddTaskConditional("Deploying nginx ingress resources", botanist.DeployNginxIngressResources, 10*time.Minute, managedDNS && controlPlaneRunning, deployKubeAddonManager).SetResumable()
+		waitUntilVPNConnectionExists         = f.AddTaskConditionalWithContext("Waiting until VPN connection exists", botanist.WaitUntilVPNConnectionExists, 0, !o.Shoot.Hibernated, deployKubeAddonManager, deployMachines)
+		applyCreateHook                      = f.AddTaskConditional("Applying create hook", seedCloudBotanist.ApplyCreateHook, defaultRetry, controlPlaneRunning, waitUntilVPNConnectionExists)
+		deploySeedMonitoring                 = f.AddTaskConditional("Deploying Shoot monitoring", botanist.DeploySeedMonitoring, defaultRetry, controlPlaneRunning, waitUntilKubeAPIServerIsReady, initializeShootClients, waitUntilVPNConnectionExists, deployMachines, applyCreateHook)
+		reencryptSecrets                     = f.AddTaskConditional("Re-encrypting secrets in Shoot", botanist.ReencryptSecrets, 2*time.Minute, controlPlaneRunning, initializeShootClients)
+		_                                    = f.AddTaskConditional("Hibernating control plane", botanist.HibernateControlPlane, defaultRetry, o.Shoot.Hibernated && controlPlaneRunning, deployKubeControllerManager, deployKubeScheduler, deployMachines, deployNginxIngressResources, deploySeedMonitoring, reencryptSecrets)
 	)
 
-	if e := f.Execute(); e != nil {
+	if e := f.Execute(ctx); e != nil {
 		e.Description = fmt.Sprintf("Failed to reconcile Shoot cluster state: %s", e.Description)
 		return e
 	}
 
+	// The flow has completed successfully, hence, the recorded state must not be used by the next reconciliation.
+	if err := o.DeleteFlowState(); err != nil {
+		o.Logger.Errorf("Could not delete the flow state: %s", err.Error())
+	}
+
+	// The etcd has been restored from the requested backup snapshot, hence, the annotation must be removed in order to not
+	// repeat the restoration in case it is requested again later.
+	if o.Shoot.EtcdRestored {
+		delete(o.Shoot.Info.Annotations, common.ShootRestoreEtcd)
+		newShoot, err := c.updater.UpdateShoot(o.Shoot.Info)
+		if err != nil {
+			return formatError("Failed to remove the etcd restoration annotation", err)
+		}
+		o.Shoot.Info = newShoot
+		c.recorder.Eventf(o.Shoot.Info, corev1.EventTypeNormal, gardenv1beta1.ShootEventEtcdRestored, "Restored etcd from backup snapshot '%s'", o.Shoot.EtcdRestoreSnapshot)
+	}
+
+	// The certificates have been rotated, hence, the annotation must be removed in order to not repeat the rotation with
+	// every reconciliation.
+	if o.Shoot.CertificatesRotated {
+		if _, ok := o.Shoot.Info.Annotations[common.ShootRotateCertificates]; ok {
+			delete(o.Shoot.Info.Annotations, common.ShootRotateCertificates)
+			newShoot, err := c.updater.UpdateShoot(o.Shoot.Info)
+			if err != nil {
+				return formatError("Failed to remove the certificate rotation annotation", err)
+			}
+			o.Shoot.Info = newShoot
+		}
+		c.recorder.Eventf(o.Shoot.Info, corev1.EventTypeNormal, gardenv1beta1.ShootEventCertificatesRotated, "Rotated certificates (%s)", o.Shoot.CertificateRotation)
+	}
+
+	// The etcd encryption key has been rotated, hence, the annotation must be removed in order to not repeat the rotation
+	// with every reconciliation.
+	if o.Shoot.EtcdEncryptionKeyRotated {
+		delete(o.Shoot.Info.Annotations, common.ShootRotateEtcdEncryptionKey)
+		newShoot, err := c.updater.UpdateShoot(o.Shoot.Info)
+		if err != nil {
+			return formatError("Failed to remove the etcd encryption key rotation annotation", err)
+		}
+		o.Shoot.Info = newShoot
+		c.recorder.Event(o.Shoot.Info, corev1.EventTypeNormal, gardenv1beta1.ShootEventEtcdEncryptionKeyRotated, "Rotated etcd encryption key and re-encrypted all secrets")
+	}
+
 	// Register the Shoot as Seed cluster if it was annotated properly and in the Gardener namespace
 	if o.Shoot.Info.Namespace == common.GardenNamespace {
 		registerAsSeed := false
@@ -127,11 +196,15 @@ func (c *defaultControl) updateShootStatusReconcileStart(o *operation.Operation,
 	o.Shoot.Info.Status.Conditions = nil
 	o.Shoot.Info.Status.Gardener = *(o.GardenerInfo)
 	o.Shoot.Info.Status.ObservedGeneration = o.Shoot.Info.Generation
+	description := "Reconciliation of Shoot cluster state in progress."
+	if operationType == gardenv1beta1.ShootLastOperationTypeMigrate {
+		description = fmt.Sprintf("Migration of Shoot cluster control plane from Seed '%s' to Seed '%s' in progress.", *o.Shoot.Info.Status.Seed, *o.Shoot.Info.Spec.Cloud.Seed)
+	}
 	o.Shoot.Info.Status.LastOperation = &gardenv1beta1.LastOperation{
 		Type:           operationType,
 		State:          gardenv1beta1.ShootLastOperationStateProcessing,
 		Progress:       1,
-		Description:    "Reconciliation of Shoot cluster state in progress.",
+		Description:    description,
 		LastUpdateTime: now,
 	}
 
@@ -143,13 +216,27 @@ func (c *defaultControl) updateShootStatusReconcileStart(o *operation.Operation,
 }
 
 func (c *defaultControl) updateShootStatusReconcileSuccess(o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType) error {
+	description := "Shoot cluster state has been successfully reconciled."
+	if operationType == gardenv1beta1.ShootLastOperationTypeMigrate {
+		description = fmt.Sprintf("Shoot cluster control plane has been successfully migrated to Seed '%s'.", *o.Shoot.Info.Spec.Cloud.Seed)
+	} else if o.Shoot.EtcdRestored {
+		description = fmt.Sprintf("Shoot cluster state has been successfully reconciled, etcd has been restored from backup snapshot '%s'.", o.Shoot.EtcdRestoreSnapshot)
+	}
+	if o.Shoot.CertificatesRotated {
+		description += " Certificates have been rotated."
+	}
+	if o.Shoot.Certificates != nil {
+		o.Shoot.Info.Status.Certificates = o.Shoot.Certificates
+	}
+
 	o.Shoot.Info.Status.RetryCycleStartTime = nil
 	o.Shoot.Info.Status.LastError = nil
+	o.Shoot.Info.Status.Seed = o.Shoot.Info.Spec.Cloud.Seed
 	o.Shoot.Info.Status.LastOperation = &gardenv1beta1.LastOperation{
 		Type:           operationType,
 		State:          gardenv1beta1.ShootLastOperationStateSucceeded,
 		Progress:       100,
-		Description:    "Shoot cluster state has been successfully reconciled.",
+		Description:    description,
 		LastUpdateTime: metav1.Now(),
 	}
 
@@ -179,6 +266,9 @@ func (c *defaultControl) updateShootStatusReconcileError(o *operation.Operation,
 		progress = lastOperation.Progress
 	}
 
+	if o.Shoot.Certificates != nil {
+		o.Shoot.Info.Status.Certificates = o.Shoot.Certificates
+	}
 	o.Shoot.Info.Status.LastError = lastError
 	o.Shoot.Info.Status.LastOperation = &gardenv1beta1.LastOperation{
 		Type:           operationType,
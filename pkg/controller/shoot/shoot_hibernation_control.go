@@ -0,0 +1,212 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+func (c *Controller) shootHibernationAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	c.shootHibernationQueue.AddAfter(key, c.config.Controllers.ShootHibernation.SyncPeriod.Duration)
+}
+
+func (c *Controller) shootHibernationDelete(obj interface{}) {
+	shoot, ok := obj.(*gardenv1beta1.Shoot)
+	if shoot == nil || !ok {
+		return
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	c.hibernationControl.Forget(key)
+	c.shootHibernationQueue.Done(key)
+}
+
+func (c *Controller) reconcileShootHibernationKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	shoot, err := c.shootLister.Shoots(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[SHOOT HIBERNATION] %s - skipping because Shoot has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[SHOOT HIBERNATION] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+	if shoot.DeletionTimestamp != nil {
+		logger.Logger.Debugf("[SHOOT HIBERNATION] %s - skipping because Shoot is marked as to be deleted", key)
+		return nil
+	}
+	defer c.shootHibernationAdd(shoot)
+	return c.hibernationControl.Hibernate(shoot, key)
+}
+
+// HibernationControlInterface implements the control logic for hibernating Shoots according to their hibernation
+// schedules. It is implemented as an interface to allow for extensions that provide different semantics. Currently,
+// there is only one implementation.
+type HibernationControlInterface interface {
+	// Hibernate checks whether one of the hibernation schedules of the given Shoot has been activated since the
+	// last check and, if so, enables or disables the hibernation in the Shoot specification. The time of a check
+	// which found an activation is persisted in an annotation of the Shoot.
+	Hibernate(shoot *gardenv1beta1.Shoot, key string) error
+	// Forget drops the time of the last check of the Shoot with the given key, e.g. after the Shoot has been deleted.
+	Forget(key string)
+}
+
+// NewDefaultHibernationControl returns a new instance of the default implementation HibernationControlInterface that
+// implements the documented semantics for hibernating Shoots. updater is the UpdaterInterface used to update the spec
+// of Shoots. You should use an instance returned from NewDefaultHibernationControl() for any scenario other than
+// testing.
+func NewDefaultHibernationControl(config *componentconfig.ControllerManagerConfiguration, recorder record.EventRecorder, updater UpdaterInterface) HibernationControlInterface {
+	return &defaultHibernationControl{
+		config:     config,
+		recorder:   recorder,
+		updater:    updater,
+		lastChecks: make(map[string]time.Time),
+	}
+}
+
+type defaultHibernationControl struct {
+	config   *componentconfig.ControllerManagerConfiguration
+	recorder record.EventRecorder
+	updater  UpdaterInterface
+
+	lastChecks     map[string]time.Time
+	lastChecksLock sync.Mutex
+}
+
+func (c *defaultHibernationControl) Hibernate(shootObj *gardenv1beta1.Shoot, key string) error {
+	var (
+		operationID = utils.GenerateRandomString(8)
+		shoot       = shootObj.DeepCopy()
+		shootLogger = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, operationID)
+		// The time is persisted with a precision of seconds, hence, we evaluate the schedules with the same precision.
+		now         = time.Now().UTC().Truncate(time.Second)
+		handleError = func(msg string) {
+			c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventHibernationError, "[%s] %s", operationID, msg)
+			shootLogger.Error(msg)
+		}
+	)
+
+	if shoot.Spec.Hibernation == nil || len(shoot.Spec.Hibernation.Schedules) == 0 {
+		c.Forget(key)
+		return nil
+	}
+
+	// Only activations of the schedules since the last check are considered. This way, a manual change of the
+	// hibernation state is respected until the next schedule is activated.
+	lastCheck := c.lastCheck(shoot, key, now)
+	activated, hibernate, err := helper.DetermineHibernationActivation(shoot.Spec.Hibernation.Schedules, lastCheck, now)
+	if err != nil {
+		handleError(fmt.Sprintf("Could not evaluate the hibernation schedules: %s", err.Error()))
+		return nil
+	}
+
+	// The time of the check is only persisted if a schedule has been activated (or if it has never been persisted),
+	// otherwise it is only kept in memory. No activation happened between the persisted time and the last check in
+	// memory, hence, after a restart of the controller the activations which fell into its downtime are applied.
+	_, persisted := shoot.Annotations[common.ShootHibernationLastCheck]
+	if !activated && persisted {
+		c.setLastCheck(key, now)
+		return nil
+	}
+
+	mustChange := activated && shoot.Spec.Hibernation.Enabled != hibernate
+	if mustChange {
+		shootLogger.Infof("[SHOOT HIBERNATION] %s", key)
+		shoot.Spec.Hibernation.Enabled = hibernate
+	}
+
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootHibernationLastCheck, now.Format(time.RFC3339))
+	if _, err := c.updater.UpdateShoot(shoot); err != nil {
+		handleError(fmt.Sprintf("Could not update the Shoot specification: %s", err.Error()))
+		return nil
+	}
+	c.setLastCheck(key, now)
+
+	if !mustChange {
+		return nil
+	}
+
+	var (
+		reason = gardenv1beta1.ShootEventHibernationDisabled
+		msg    = "Hibernation schedule activated; the Shoot will be woken up."
+	)
+	if hibernate {
+		reason = gardenv1beta1.ShootEventHibernationEnabled
+		msg = "Hibernation schedule activated; the Shoot will be hibernated."
+	}
+	shootLogger.Infof("[SHOOT HIBERNATION] %s", msg)
+	c.recorder.Eventf(shoot, corev1.EventTypeNormal, reason, "[%s] %s", operationID, msg)
+
+	return nil
+}
+
+func (c *defaultHibernationControl) Forget(key string) {
+	c.lastChecksLock.Lock()
+	defer c.lastChecksLock.Unlock()
+	delete(c.lastChecks, key)
+}
+
+func (c *defaultHibernationControl) setLastCheck(key string, lastCheck time.Time) {
+	c.lastChecksLock.Lock()
+	defer c.lastChecksLock.Unlock()
+	c.lastChecks[key] = lastCheck
+}
+
+// lastCheck returns the time of the last check of the hibernation schedules of the given <shoot>, i.e. the later one
+// of the time kept in memory for the given <key> and the time persisted in the annotations of the <shoot>. If the
+// schedules have not been checked yet then the beginning of the current sync period is returned.
+func (c *defaultHibernationControl) lastCheck(shoot *gardenv1beta1.Shoot, key string, now time.Time) time.Time {
+	var lastCheck time.Time
+	if value, ok := shoot.Annotations[common.ShootHibernationLastCheck]; ok {
+		if persisted, err := time.Parse(time.RFC3339, value); err == nil && !persisted.After(now) {
+			lastCheck = persisted
+		}
+	}
+
+	c.lastChecksLock.Lock()
+	defer c.lastChecksLock.Unlock()
+	if inMemory, ok := c.lastChecks[key]; ok && inMemory.After(lastCheck) && !inMemory.After(now) {
+		lastCheck = inMemory
+	}
+
+	if lastCheck.IsZero() {
+		return now.Add(-c.config.Controllers.ShootHibernation.SyncPeriod.Duration)
+	}
+	return lastCheck
+}
@@ -0,0 +1,133 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controller/shoot"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// fakeUpdater records the Shoots which have been updated. The status updates are not implemented.
+type fakeUpdater struct {
+	UpdaterInterface
+
+	updated []*gardenv1beta1.Shoot
+}
+
+func (u *fakeUpdater) UpdateShoot(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+	u.updated = append(u.updated, shoot)
+	return shoot, nil
+}
+
+var _ = Describe("hibernation control", func() {
+	var (
+		updater *fakeUpdater
+		control HibernationControlInterface
+		shoot   *gardenv1beta1.Shoot
+
+		// dailyAt returns a Cron spec which is activated every day at the given time.
+		dailyAt = func(t time.Time) *string {
+			spec := fmt.Sprintf("%d %d * * *", t.UTC().Minute(), t.UTC().Hour())
+			return &spec
+		}
+		everyMinute = "* * * * *"
+	)
+
+	BeforeEach(func() {
+		logger.Logger = logger.NewLogger("")
+		updater = &fakeUpdater{}
+		control = NewDefaultHibernationControl(&componentconfig.ControllerManagerConfiguration{
+			Controllers: componentconfig.ControllerManagerControllerConfiguration{
+				ShootHibernation: componentconfig.ShootHibernationControllerConfiguration{
+					SyncPeriod: metav1.Duration{Duration: time.Minute},
+				},
+			},
+		}, record.NewFakeRecorder(10), updater)
+		shoot = &gardenv1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bar",
+				Namespace:   "garden-foo",
+				Annotations: map[string]string{common.ShootHibernationLastCheck: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)},
+			},
+			Spec: gardenv1beta1.ShootSpec{
+				Hibernation: &gardenv1beta1.Hibernation{
+					Schedules: []gardenv1beta1.HibernationSchedule{
+						{Start: dailyAt(time.Now().Add(3 * time.Hour))},
+					},
+				},
+			},
+		}
+	})
+
+	It("should not update the Shoot if no schedule has been activated since the last check", func() {
+		Expect(control.Hibernate(shoot, "garden-foo/bar")).To(Succeed())
+
+		Expect(updater.updated).To(BeEmpty())
+	})
+
+	It("should persist the time of the first check", func() {
+		delete(shoot.Annotations, common.ShootHibernationLastCheck)
+
+		Expect(control.Hibernate(shoot, "garden-foo/bar")).To(Succeed())
+
+		Expect(updater.updated).To(HaveLen(1))
+		Expect(updater.updated[0].Annotations).To(HaveKey(common.ShootHibernationLastCheck))
+		Expect(updater.updated[0].Spec.Hibernation.Enabled).To(BeFalse())
+	})
+
+	It("should hibernate the Shoot and persist the time of the check if a schedule has been activated", func() {
+		shoot.Spec.Hibernation.Schedules[0].Start = &everyMinute
+
+		Expect(control.Hibernate(shoot, "garden-foo/bar")).To(Succeed())
+
+		Expect(updater.updated).To(HaveLen(1))
+		Expect(updater.updated[0].Spec.Hibernation.Enabled).To(BeTrue())
+		Expect(updater.updated[0].Annotations[common.ShootHibernationLastCheck]).NotTo(Equal(shoot.Annotations[common.ShootHibernationLastCheck]))
+	})
+
+	It("should not consider activations again which have been found by a previous check", func() {
+		shoot.Spec.Hibernation.Schedules[0].Start = &everyMinute
+		Expect(control.Hibernate(shoot, "garden-foo/bar")).To(Succeed())
+		updater.updated = nil
+
+		// The Shoot might still be outdated, i.e. neither hibernated nor annotated with the time of the previous check.
+		Expect(control.Hibernate(shoot, "garden-foo/bar")).To(Succeed())
+
+		Expect(updater.updated).To(BeEmpty())
+	})
+
+	It("should consider activations since the persisted check if the previous checks have been forgotten", func() {
+		Expect(control.Hibernate(shoot, "garden-foo/bar")).To(Succeed())
+		control.Forget("garden-foo/bar")
+		shoot.Spec.Hibernation.Schedules[0].Start = dailyAt(time.Now().Add(-30 * time.Minute))
+
+		Expect(control.Hibernate(shoot, "garden-foo/bar")).To(Succeed())
+
+		Expect(updater.updated).To(HaveLen(1))
+		Expect(updater.updated[0].Spec.Hibernation.Enabled).To(BeTrue())
+	})
+})
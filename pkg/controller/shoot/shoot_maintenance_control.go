@@ -159,6 +159,13 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 			}
 		}
 
+		// Check if the CloudProfile contains another version of the machine images of the worker groups which
+		// override the cluster-wide machine image.
+		if err := maintainWorkerMachineImages(*operation.Shoot.CloudProfile, operation.Shoot.CloudProvider, shoot); err != nil {
+			handleError(fmt.Sprintf("Failure while determining the worker machine images in the CloudProfile: %s", err.Error()))
+			return nil
+		}
+
 		// Check if the CloudProfile contains a newer Kubernetes patch version.
 		if shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion {
 			newerPatchVersionFound, latestPatchVersion, err := helper.DetermineLatestKubernetesVersion(*operation.Shoot.CloudProfile, operation.Shoot.Info.Spec.Kubernetes.Version)
@@ -183,3 +190,66 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 
 	return nil
 }
+
+// maintainWorkerMachineImages updates the machine images of all worker groups of the given <shoot> which override the
+// cluster-wide machine image to the versions found in the <cloudProfile>.
+func maintainWorkerMachineImages(cloudProfile gardenv1beta1.CloudProfile, cloudProvider gardenv1beta1.CloudProvider, shoot *gardenv1beta1.Shoot) error {
+	region := shoot.Spec.Cloud.Region
+
+	switch cloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		for i, worker := range shoot.Spec.Cloud.AWS.Workers {
+			if worker.MachineImage == nil {
+				continue
+			}
+			machineImageFound, machineImage, err := helper.DetermineMachineImage(cloudProfile, worker.MachineImage.Name, region)
+			if err != nil {
+				return err
+			}
+			if machineImageFound {
+				shoot.Spec.Cloud.AWS.Workers[i].MachineImage = machineImage.(*gardenv1beta1.AWSMachineImage)
+			}
+		}
+	case gardenv1beta1.CloudProviderAzure:
+		for i, worker := range shoot.Spec.Cloud.Azure.Workers {
+			if worker.MachineImage == nil {
+				continue
+			}
+			machineImageFound, machineImage, err := helper.DetermineMachineImage(cloudProfile, worker.MachineImage.Name, region)
+			if err != nil {
+				return err
+			}
+			if machineImageFound {
+				shoot.Spec.Cloud.Azure.Workers[i].MachineImage = machineImage.(*gardenv1beta1.AzureMachineImage)
+			}
+		}
+	case gardenv1beta1.CloudProviderGCP:
+		for i, worker := range shoot.Spec.Cloud.GCP.Workers {
+			if worker.MachineImage == nil {
+				continue
+			}
+			machineImageFound, machineImage, err := helper.DetermineMachineImage(cloudProfile, worker.MachineImage.Name, region)
+			if err != nil {
+				return err
+			}
+			if machineImageFound {
+				shoot.Spec.Cloud.GCP.Workers[i].MachineImage = machineImage.(*gardenv1beta1.GCPMachineImage)
+			}
+		}
+	case gardenv1beta1.CloudProviderOpenStack:
+		for i, worker := range shoot.Spec.Cloud.OpenStack.Workers {
+			if worker.MachineImage == nil {
+				continue
+			}
+			machineImageFound, machineImage, err := helper.DetermineMachineImage(cloudProfile, worker.MachineImage.Name, region)
+			if err != nil {
+				return err
+			}
+			if machineImageFound {
+				shoot.Spec.Cloud.OpenStack.Workers[i].MachineImage = machineImage.(*gardenv1beta1.OpenStackMachineImage)
+			}
+		}
+	}
+
+	return nil
+}
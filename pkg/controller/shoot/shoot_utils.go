@@ -16,9 +16,13 @@ package shoot
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // operationOngoing returns true if the .status.phase field has a value which indicates that an operation
@@ -52,9 +56,77 @@ func computeLabelsWithShootHealthiness(shoot *gardenv1beta1.Shoot, healthy bool)
 	return labels
 }
 
-func computeOperationType(lastOperation *gardenv1beta1.LastOperation) gardenv1beta1.ShootLastOperationType {
+func computeOperationType(shoot *gardenv1beta1.Shoot) gardenv1beta1.ShootLastOperationType {
+	lastOperation := shoot.Status.LastOperation
 	if lastOperation == nil || (lastOperation.Type == gardenv1beta1.ShootLastOperationTypeCreate && lastOperation.State != gardenv1beta1.ShootLastOperationStateSucceeded) {
 		return gardenv1beta1.ShootLastOperationTypeCreate
 	}
+	if mustMigrateControlPlane(shoot) {
+		return gardenv1beta1.ShootLastOperationTypeMigrate
+	}
 	return gardenv1beta1.ShootLastOperationTypeReconcile
 }
+
+// mustMigrateControlPlane returns true if the control plane of the given <shoot> is hosted on another Seed cluster than
+// the one referenced in its specification.
+func mustMigrateControlPlane(shoot *gardenv1beta1.Shoot) bool {
+	return shoot.Status.Seed != nil && shoot.Spec.Cloud.Seed != nil && *shoot.Status.Seed != *shoot.Spec.Cloud.Seed
+}
+
+// mustRenewCertificates returns true if any of the leaf certificates of the given <shoot> (as recorded in its status)
+// expires within the given <threshold>. The CA certificate is not considered as it can only be rotated on request.
+func mustRenewCertificates(shoot *gardenv1beta1.Shoot, threshold *metav1.Duration) bool {
+	if threshold == nil {
+		return false
+	}
+	for _, certificate := range shoot.Status.Certificates {
+		if certificate.Name != "ca" && time.Until(certificate.NotAfter.Time) < threshold.Duration {
+			return true
+		}
+	}
+	return false
+}
+
+// computeCertificateSettings overwrites the given certificate <settings> with the globally configured ones <config>
+// and afterwards with the ones specified by annotations on the given <shoot>.
+func computeCertificateSettings(settings shootpkg.CertificateSettings, config *componentconfig.CertificatesConfiguration, shoot *gardenv1beta1.Shoot) (shootpkg.CertificateSettings, error) {
+	if config != nil {
+		if config.KeyAlgorithm != nil {
+			settings.KeyAlgorithm = *config.KeyAlgorithm
+		}
+		if config.RSAKeySize != nil {
+			settings.RSAKeySize = *config.RSAKeySize
+		}
+		if config.CAValidity != nil {
+			settings.CAValidity = config.CAValidity.Duration
+		}
+		if config.LeafValidity != nil {
+			settings.LeafValidity = config.LeafValidity.Duration
+		}
+	}
+
+	if keyAlgorithm, ok := shoot.Annotations[common.ShootCertificateKeyAlgorithm]; ok {
+		settings.KeyAlgorithm = keyAlgorithm
+	}
+	if validity, ok := shoot.Annotations[common.ShootCertificateValidity]; ok {
+		duration, err := time.ParseDuration(validity)
+		if err != nil {
+			return settings, fmt.Errorf("invalid value of annotation '%s': %s", common.ShootCertificateValidity, err.Error())
+		}
+		settings.LeafValidity = duration
+	}
+
+	if settings.KeyAlgorithm != common.CertificateKeyAlgorithmRSA && settings.KeyAlgorithm != common.CertificateKeyAlgorithmECDSA {
+		return settings, fmt.Errorf("unsupported key algorithm '%s' (must be one of '%s', '%s')", settings.KeyAlgorithm, common.CertificateKeyAlgorithmRSA, common.CertificateKeyAlgorithmECDSA)
+	}
+	if settings.RSAKeySize < 2048 {
+		return settings, fmt.Errorf("RSA key size must be at least 2048 bits, got %d", settings.RSAKeySize)
+	}
+	if settings.CAValidity <= 0 || settings.LeafValidity <= 0 {
+		return settings, fmt.Errorf("certificate validity periods must be positive")
+	}
+	if settings.LeafValidity > settings.CAValidity {
+		return settings, fmt.Errorf("validity period of leaf certificates (%s) must not exceed the one of the CA (%s)", settings.LeafValidity, settings.CAValidity)
+	}
+	return settings, nil
+}
@@ -401,6 +401,66 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "int32",
 							},
 						},
+						"labels": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Labels is a map of key/value pairs which are added to the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"annotations": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Annotations is a map of key/value pairs which are added to the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"taints": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Taints is a list of taints which are added to the nodes of the worker group.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/api/core/v1.Taint"),
+										},
+									},
+								},
+							},
+						},
+						"kubeletVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "KubeletVersion is the version of the kubelet running on the nodes of the worker group. It must not be newer than the version of the control plane and at most two minor versions older. It defaults to the Kubernetes version of the Shoot cluster if not set.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"maxSurge": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxSurge is the maximum number of VMs that are created during a rolling update of the worker group (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
+						"maxUnavailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during a rolling update of the worker group (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
 						"volumeType": {
 							SchemaProps: spec.SchemaProps{
 								Description: "VolumeType is the type of the root volumes.",
@@ -415,11 +475,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"machineImage": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MachineImage holds information about the machine image to use for this worker group. It overrides the cluster-wide machine image if set.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImage"),
+							},
+						},
 					},
 					Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
 				},
 			},
-			Dependencies: []string{},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addon": {
 			Schema: spec.Schema{
@@ -492,6 +559,207 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			Dependencies: []string{
 				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress"},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "AdmissionPlugin contains information about a specific admission plugin and its corresponding configuration.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the plugin.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"enabled": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Enabled indicates whether the plugin is enabled or disabled (default true).",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"config": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Config is the configuration of the plugin (a YAML or JSON document).",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"name"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlertReceiver": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "AlertReceiver contains information about a receiver which is notified about the alerts of the Shoot. Exactly one of Email, Slack, PagerDuty and Webhook must be set.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the receiver.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"severities": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Severities is the list of alert severities the receiver is notified about (default [\"critical\", \"blocker\"]).",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"email": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Email contains the configuration of an email receiver.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.EmailReceiver"),
+							},
+						},
+						"slack": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Slack contains the configuration of a Slack receiver.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SlackReceiver"),
+							},
+						},
+						"pagerDuty": {
+							SchemaProps: spec.SchemaProps{
+								Description: "PagerDuty contains the configuration of a PagerDuty receiver.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PagerDutyReceiver"),
+							},
+						},
+						"webhook": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Webhook contains the configuration of a generic webhook receiver.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WebhookReceiver"),
+							},
+						},
+					},
+					Required: []string{"name"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.EmailReceiver", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PagerDutyReceiver", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SlackReceiver", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WebhookReceiver"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alerting": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "Alerting contains information about the alerting configuration of the Shoot.",
+					Properties: map[string]spec.Schema{
+						"receivers": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Receivers is a list of receivers which are notified about the alerts of the Shoot.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlertReceiver"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlertReceiver"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "AuditConfig contains settings for the audit of the kube-apiserver.",
+					Properties: map[string]spec.Schema{
+						"auditPolicy": {
+							SchemaProps: spec.SchemaProps{
+								Description: "AuditPolicy contains configuration settings for the audit policy of the kube-apiserver.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy"),
+							},
+						},
+						"log": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Log contains configuration settings for the log backend of the audit. If set, audit events are written to the standard output of the kube-apiserver container.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditLogBackend"),
+							},
+						},
+						"webhook": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Webhook contains configuration settings for the webhook backend of the audit. If set, audit events are sent to the configured remote API.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditWebhookBackend"),
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditLogBackend", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditWebhookBackend"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditLogBackend": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "AuditLogBackend contains configuration settings for the log backend of the audit.",
+					Properties: map[string]spec.Schema{
+						"format": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Format is the format of the written audit events. Known formats are \"legacy\" and \"json\" (default \"json\").",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "AuditPolicy contains configuration settings for the audit policy of the kube-apiserver.",
+					Properties: map[string]spec.Schema{
+						"configMapRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ConfigMapRef is a reference to a ConfigMap object in the same namespace as the Shoot which contains the audit policy for the kube-apiserver in its `policy` key.",
+								Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/api/core/v1.LocalObjectReference"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditWebhookBackend": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "AuditWebhookBackend contains configuration settings for the webhook backend of the audit.",
+					Properties: map[string]spec.Schema{
+						"kubeconfigSecretRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "KubeconfigSecretRef is a reference to a Secret object in the same namespace as the Shoot which contains a kubeconfig for the remote API in its `kubeconfig` key.",
+								Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							},
+						},
+						"mode": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Mode is the strategy for sending audit events. Known modes are \"batch\" and \"blocking\" (default \"batch\").",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"kubeconfigSecretRef"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/api/core/v1.LocalObjectReference"},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -841,6 +1109,66 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "int32",
 							},
 						},
+						"labels": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Labels is a map of key/value pairs which are added to the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"annotations": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Annotations is a map of key/value pairs which are added to the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"taints": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Taints is a list of taints which are added to the nodes of the worker group.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/api/core/v1.Taint"),
+										},
+									},
+								},
+							},
+						},
+						"kubeletVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "KubeletVersion is the version of the kubelet running on the nodes of the worker group. It must not be newer than the version of the control plane and at most two minor versions older. It defaults to the Kubernetes version of the Shoot cluster if not set.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"maxSurge": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxSurge is the maximum number of VMs that are created during a rolling update of the worker group (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
+						"maxUnavailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during a rolling update of the worker group (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
 						"volumeType": {
 							SchemaProps: spec.SchemaProps{
 								Description: "VolumeType is the type of the root volumes.",
@@ -855,11 +1183,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"machineImage": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MachineImage holds information about the machine image to use for this worker group. It overrides the cluster-wide machine image if set.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureMachineImage"),
+							},
+						},
 					},
 					Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
 				},
 			},
-			Dependencies: []string{},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup": {
 			Schema: spec.Schema{
@@ -886,6 +1221,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			},
 			Dependencies: []string{},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Certificate": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "Certificate holds information about a certificate used by the control plane of a Shoot cluster.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the secret in the Shoot namespace of the Seed cluster which contains the certificate.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"notAfter": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NotAfter is the time at which the certificate expires.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+					},
+					Required: []string{"name", "notAfter"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -948,6 +1308,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VagrantLocal"),
 							},
 						},
+						"sequentialWorkerRollout": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SequentialWorkerRollout indicates whether changes to the worker groups are rolled out one worker group after the other (in the order of their declaration) instead of all at once.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
 					},
 					Required: []string{"profile", "region", "secretBindingRef"},
 				},
@@ -1206,6 +1573,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			},
 			Dependencies: []string{},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.EmailReceiver": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "EmailReceiver contains the configuration of an email receiver. The emails are sent via the SMTP server configured for the Gardener.",
+					Properties: map[string]spec.Schema{
+						"to": {
+							SchemaProps: spec.SchemaProps{
+								Description: "To is the list of email addresses to send notifications to.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+					},
+					Required: []string{"to"},
+				},
+			},
+			Dependencies: []string{},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -1307,6 +1699,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								},
 							},
 						},
+						"preemptibleMachineTypes": {
+							SchemaProps: spec.SchemaProps{
+								Description: "PreemptibleMachineTypes contains the names of the machine types which may be used for preemptible VMs.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
 						"volumeTypes": {
 							SchemaProps: spec.SchemaProps{
 								Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
@@ -1448,43 +1854,103 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
-					},
-					Required: []string{"name"},
-				},
-			},
-			Dependencies: []string{},
-		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker": {
-			Schema: spec.Schema{
-				SchemaProps: spec.SchemaProps{
-					Description: "GCPWorker is the definition of a worker group.",
-					Properties: map[string]spec.Schema{
-						"name": {
+					},
+					Required: []string{"name"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "GCPWorker is the definition of a worker group.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the worker group.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"machineType": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MachineType is the machine type of the worker group.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"autoScalerMin": {
+							SchemaProps: spec.SchemaProps{
+								Description: "AutoScalerMin is the minimum number of VMs to create.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"autoScalerMax": {
+							SchemaProps: spec.SchemaProps{
+								Description: "AutoScalerMin is the maximum number of VMs to create.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"labels": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Labels is a map of key/value pairs which are added to the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"annotations": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Annotations is a map of key/value pairs which are added to the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"taints": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Name is the name of the worker group.",
-								Type:        []string{"string"},
-								Format:      "",
+								Description: "Taints is a list of taints which are added to the nodes of the worker group.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/api/core/v1.Taint"),
+										},
+									},
+								},
 							},
 						},
-						"machineType": {
+						"kubeletVersion": {
 							SchemaProps: spec.SchemaProps{
-								Description: "MachineType is the machine type of the worker group.",
+								Description: "KubeletVersion is the version of the kubelet running on the nodes of the worker group. It must not be newer than the version of the control plane and at most two minor versions older. It defaults to the Kubernetes version of the Shoot cluster if not set.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"autoScalerMin": {
+						"maxSurge": {
 							SchemaProps: spec.SchemaProps{
-								Description: "AutoScalerMin is the minimum number of VMs to create.",
-								Type:        []string{"integer"},
-								Format:      "int32",
+								Description: "MaxSurge is the maximum number of VMs that are created during a rolling update of the worker group (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 							},
 						},
-						"autoScalerMax": {
+						"maxUnavailable": {
 							SchemaProps: spec.SchemaProps{
-								Description: "AutoScalerMin is the maximum number of VMs to create.",
-								Type:        []string{"integer"},
-								Format:      "int32",
+								Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during a rolling update of the worker group (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 							},
 						},
 						"volumeType": {
@@ -1501,11 +1967,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"machineImage": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MachineImage holds information about the machine image to use for this worker group. It overrides the cluster-wide machine image if set.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage"),
+							},
+						},
+						"preemptible": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Preemptible indicates whether the machines of this worker group shall be preemptible VMs.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
 					},
 					Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
 				},
 			},
-			Dependencies: []string{},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener": {
 			Schema: spec.Schema{
@@ -1575,6 +2055,98 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			},
 			Dependencies: []string{},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "Hibernation contains information whether the Shoot is suspended or not.",
+					Properties: map[string]spec.Schema{
+						"enabled": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Enabled is true if the Shoot's desired state is hibernated, false otherwise.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"schedules": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Schedules determine the hibernation schedules.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule"),
+										},
+									},
+								},
+							},
+						},
+					},
+					Required: []string{"enabled"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "HibernationSchedule determines the hibernation schedule of a Shoot. A Shoot will be regularly hibernated at each start time and will be woken up at each end time. Start or End can be omitted, though at least one of them has to be specified.",
+					Properties: map[string]spec.Schema{
+						"start": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Start is a Cron spec at which time a Shoot will be hibernated.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"end": {
+							SchemaProps: spec.SchemaProps{
+								Description: "End is a Cron spec at which time a Shoot will be woken up.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"location": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Location is the time location in which both start and end shall be evaluated. Defaults to UTC.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager. Note: Descriptions were taken from the Kubernetes documentation.",
+					Properties: map[string]spec.Schema{
+						"syncPeriod": {
+							SchemaProps: spec.SchemaProps{
+								Description: "The period for syncing the number of pods in horizontal pod autoscaler (default 30s).",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+						"downscaleDelay": {
+							SchemaProps: spec.SchemaProps{
+								Description: "The period since last downscale, before another downscale can be performed in horizontal pod autoscaler (default 5m0s).",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+						"upscaleDelay": {
+							SchemaProps: spec.SchemaProps{
+								Description: "The period since last upscale, before another upscale can be performed in horizontal pod autoscaler (default 3m0s).",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.K8SNetworks": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -1709,11 +2281,30 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"),
 							},
 						},
+						"auditConfig": {
+							SchemaProps: spec.SchemaProps{
+								Description: "AuditConfig contains configuration settings for the audit of the kube-apiserver.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig"),
+							},
+						},
+						"admissionPlugins": {
+							SchemaProps: spec.SchemaProps{
+								Description: "AdmissionPlugins contains the list of user-defined admission plugins (additional to those managed by Gardener) and, if desired, the corresponding configuration.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin"),
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig": {
 			Schema: spec.Schema{
@@ -1734,10 +2325,23 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								},
 							},
 						},
+						"horizontalPodAutoscaler": {
+							SchemaProps: spec.SchemaProps{
+								Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig"),
+							},
+						},
+						"nodeMonitorGracePeriod": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NodeMonitorGracePeriod is the amount of time which a running node is allowed to be unresponsive before it is marked unhealthy (default 40s).",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
 					},
 				},
 			},
-			Dependencies: []string{},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego": {
 			Schema: spec.Schema{
@@ -1783,6 +2387,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								},
 							},
 						},
+						"mode": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Mode specifies which proxy mode to use (default IPTables).",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 				},
 			},
@@ -1831,11 +2442,143 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								},
 							},
 						},
+						"cpuManagerPolicy": {
+							SchemaProps: spec.SchemaProps{
+								Description: "CPUManagerPolicy allows to set alternative CPU management policies (default none).",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"evictionHard": {
+							SchemaProps: spec.SchemaProps{
+								Description: "EvictionHard describes a set of eviction thresholds (e.g. memory.available<1Gi) that if met would trigger a pod eviction.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction"),
+							},
+						},
+						"evictionSoft": {
+							SchemaProps: spec.SchemaProps{
+								Description: "EvictionSoft describes a set of eviction thresholds (e.g. memory.available<1.5Gi) that if met over a corresponding grace period would trigger a pod eviction.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction"),
+							},
+						},
+						"evictionSoftGracePeriod": {
+							SchemaProps: spec.SchemaProps{
+								Description: "EvictionSoftGracePeriod describes a set of eviction grace periods (e.g. memory.available=1m30s) that correspond to how long a soft eviction threshold must hold before triggering a pod eviction.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionSoftGracePeriod"),
+							},
+						},
+						"evictionPressureTransitionPeriod": {
+							SchemaProps: spec.SchemaProps{
+								Description: "EvictionPressureTransitionPeriod is the duration for which the kubelet has to wait before transitioning out of an eviction pressure condition.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+						"evictionMaxPodGracePeriod": {
+							SchemaProps: spec.SchemaProps{
+								Description: "EvictionMaxPodGracePeriod is the maximum allowed grace period (in seconds) to use when terminating pods in response to a soft eviction threshold being met.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"maxPods": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxPods is the maximum number of Pods that are allowed by the Kubelet (default 110).",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionSoftGracePeriod", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.",
+					Properties: map[string]spec.Schema{
+						"memoryAvailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MemoryAvailable is the threshold for the free memory on the host server.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"imageFSAvailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ImageFSAvailable is the threshold for the free disk space in the imagefs filesystem (docker images and container writable layers).",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"imageFSInodesFree": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ImageFSInodesFree is the threshold for the available inodes in the imagefs filesystem.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"nodeFSAvailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NodeFSAvailable is the threshold for the free disk space in the nodefs filesystem (docker volumes, logs, etc).",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"nodeFSInodesFree": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NodeFSInodesFree is the threshold for the available inodes in the nodefs filesystem.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 				},
 			},
 			Dependencies: []string{},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionSoftGracePeriod": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "KubeletConfigEvictionSoftGracePeriod contains grace periods for kubelet eviction thresholds.",
+					Properties: map[string]spec.Schema{
+						"memoryAvailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MemoryAvailable is the grace period for the MemoryAvailable eviction threshold.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+						"imageFSAvailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ImageFSAvailable is the grace period for the ImageFSAvailable eviction threshold.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+						"imageFSInodesFree": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ImageFSInodesFree is the grace period for the ImageFSInodesFree eviction threshold.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+						"nodeFSAvailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NodeFSAvailable is the grace period for the NodeFSAvailable eviction threshold.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+						"nodeFSInodesFree": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NodeFSInodesFree is the grace period for the NodeFSInodesFree eviction threshold.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -2135,10 +2878,51 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 							},
 						},
 					},
-					Required: []string{"begin", "end"},
+					Required: []string{"begin", "end"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monitoring": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "Monitoring contains information about the monitoring configuration of the Shoot.",
+					Properties: map[string]spec.Schema{
+						"alerting": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Alerting contains information about the alerting configuration of the Shoot.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alerting"),
+							},
+						},
+						"rules": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Rules contains information about additional Prometheus recording and alerting rules for the Shoot.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MonitoringRules"),
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alerting", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MonitoringRules"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MonitoringRules": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "MonitoringRules contains information about additional Prometheus recording and alerting rules for the Shoot.",
+					Properties: map[string]spec.Schema{
+						"configMapRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ConfigMapRef is a reference to a ConfigMap object in the same namespace as the Shoot which contains Prometheus rule files (one rule file per key).",
+								Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							},
+						},
+					},
+					Required: []string{"configMapRef"},
 				},
 			},
-			Dependencies: []string{},
+			Dependencies: []string{
+				"k8s.io/api/core/v1.LocalObjectReference"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular": {
 			Schema: spec.Schema{
@@ -2650,11 +3434,96 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "int32",
 							},
 						},
+						"labels": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Labels is a map of key/value pairs which are added to the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"annotations": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Annotations is a map of key/value pairs which are added to the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"taints": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Taints is a list of taints which are added to the nodes of the worker group.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/api/core/v1.Taint"),
+										},
+									},
+								},
+							},
+						},
+						"kubeletVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "KubeletVersion is the version of the kubelet running on the nodes of the worker group. It must not be newer than the version of the control plane and at most two minor versions older. It defaults to the Kubernetes version of the Shoot cluster if not set.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"maxSurge": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxSurge is the maximum number of VMs that are created during a rolling update of the worker group (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
+						"maxUnavailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during a rolling update of the worker group (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
+						"machineImage": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MachineImage holds information about the machine image to use for this worker group. It overrides the cluster-wide machine image if set.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage"),
+							},
+						},
 					},
 					Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 				},
 			},
-			Dependencies: []string{},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PagerDutyReceiver": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "PagerDutyReceiver contains the configuration of a PagerDuty receiver.",
+					Properties: map[string]spec.Schema{
+						"secretRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SecretRef is a reference to a Secret object in the same namespace as the Shoot which contains the integration key of the PagerDuty Events API v2 in its `routingKey` key.",
+								Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							},
+						},
+					},
+					Required: []string{"secretRef"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/api/core/v1.LocalObjectReference"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota": {
 			Schema: spec.Schema{
@@ -2686,11 +3555,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec"),
 							},
 						},
+						"status": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Status contains the most recently observed usage of the Quota.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaStatus"),
+							},
+						},
 					},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaList": {
 			Schema: spec.Schema{
@@ -2737,6 +3612,45 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			Dependencies: []string{
 				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaShootUsage": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "QuotaShootUsage is the amount of the constrained resources which is allocated by a single Shoot.",
+					Properties: map[string]spec.Schema{
+						"namespace": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Namespace is the namespace of the Shoot.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the Shoot.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"used": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Used is the amount of the constrained resources which is allocated by the Shoot.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										},
+									},
+								},
+							},
+						},
+					},
+					Required: []string{"namespace", "name"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -2776,6 +3690,43 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			Dependencies: []string{
 				"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaStatus": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "QuotaStatus holds the most recently observed usage of a Quota.",
+					Properties: map[string]spec.Schema{
+						"used": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Used is the amount of the constrained resources which is allocated by all Shoots consuming the Quota.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										},
+									},
+								},
+							},
+						},
+						"shoots": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Shoots is the list of Shoots which consume the Quota together with the resources each of them allocates.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaShootUsage"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaShootUsage", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -3217,6 +4168,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS"),
 							},
 						},
+						"hibernation": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Hibernation contains information whether the Shoot is suspended or not and the schedules which determine when it is automatically hibernated or woken up.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation"),
+							},
+						},
 						"kubernetes": {
 							SchemaProps: spec.SchemaProps{
 								Description: "Kubernetes contains the version and configuration settings of the control plane components.",
@@ -3229,12 +4186,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"),
 							},
 						},
+						"monitoring": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Monitoring contains information about the monitoring configuration of the Shoot.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monitoring"),
+							},
+						},
 					},
 					Required: []string{"cloud", "dns", "kubernetes"},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monitoring"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus": {
 			Schema: spec.Schema{
@@ -3292,12 +4255,57 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"seed": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Seed is the name of the Seed cluster which currently hosts the control plane of the Shoot cluster. If it differs from the Seed cluster referenced in the specification then the control plane is migrated.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"certificates": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Certificates contains the expiration dates of the certificates used by the control plane of the Shoot cluster.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Certificate"),
+										},
+									},
+								},
+							},
+						},
 					},
 					Required: []string{"gardener", "uid"},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Certificate", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SlackReceiver": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "SlackReceiver contains the configuration of a Slack receiver.",
+					Properties: map[string]spec.Schema{
+						"secretRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SecretRef is a reference to a Secret object in the same namespace as the Shoot which contains the URL of the Slack webhook in its `url` key.",
+								Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							},
+						},
+						"channel": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Channel is the Slack channel or user to send notifications to.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"secretRef"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/api/core/v1.LocalObjectReference"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VagrantConstraints": {
 			Schema: spec.Schema{
@@ -3423,6 +4431,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			},
 			Dependencies: []string{},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WebhookReceiver": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "WebhookReceiver contains the configuration of a generic webhook receiver.",
+					Properties: map[string]spec.Schema{
+						"secretRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SecretRef is a reference to a Secret object in the same namespace as the Shoot which contains the URL of the webhook in its `url` key.",
+								Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							},
+						},
+					},
+					Required: []string{"secretRef"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/api/core/v1.LocalObjectReference"},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -3456,11 +4482,72 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "int32",
 							},
 						},
+						"labels": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Labels is a map of key/value pairs which are added to the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"annotations": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Annotations is a map of key/value pairs which are added to the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"taints": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Taints is a list of taints which are added to the nodes of the worker group.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/api/core/v1.Taint"),
+										},
+									},
+								},
+							},
+						},
+						"kubeletVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "KubeletVersion is the version of the kubelet running on the nodes of the worker group. It must not be newer than the version of the control plane and at most two minor versions older. It defaults to the Kubernetes version of the Shoot cluster if not set.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"maxSurge": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxSurge is the maximum number of VMs that are created during a rolling update of the worker group (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
+						"maxUnavailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during a rolling update of the worker group (per zone). It can be an absolute number or a percentage of the desired number of VMs. It defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
 					},
 					Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 				},
 			},
-			Dependencies: []string{},
+			Dependencies: []string{
+				"k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone": {
 			Schema: spec.Schema{
@@ -17,7 +17,9 @@ package botanist
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
@@ -120,6 +122,7 @@ func (b *Botanist) DeploySeedMonitoring() error {
 		alertManagerHost = b.Seed.GetIngressFQDN("a", b.Shoot.Info.Name, b.Garden.ProjectName)
 		grafanaHost      = b.Seed.GetIngressFQDN("g", b.Shoot.Info.Name, b.Garden.ProjectName)
 		prometheusHost   = b.Seed.GetIngressFQDN("p", b.Shoot.Info.Name, b.Garden.ProjectName)
+		federationHost   = b.Seed.GetIngressFQDN("pf", b.Shoot.Info.Name, b.Garden.ProjectName)
 		replicas         = 1
 	)
 
@@ -152,6 +155,11 @@ func (b *Botanist) DeploySeedMonitoring() error {
 				"basicAuthSecret": basicAuth,
 				"host":            prometheusHost,
 			},
+			// The garden monitoring federates the Prometheus via a dedicated Ingress which requires the client
+			// certificate of the Prometheus (signed by the CA of the Shoot cluster).
+			"federation": map[string]interface{}{
+				"host": federationHost,
+			},
 			"namespace": map[string]interface{}{
 				"uid": b.SeedNamespaceObject.UID,
 			},
@@ -233,9 +241,138 @@ func (b *Botanist) DeploySeedMonitoring() error {
 		values["alertmanager"].(map[string]interface{})["email_configs"] = emailConfigs
 	}
 
+	receivers, err := b.generateAlertReceiverValues()
+	if err != nil {
+		return err
+	}
+	values["alertmanager"].(map[string]interface{})["receivers"] = receivers
+
+	shootRules, err := b.generateShootRuleValues()
+	if err != nil {
+		return err
+	}
+	values["prometheus"].(map[string]interface{})["shootRules"] = shootRules
+
 	return b.ApplyChartSeed(filepath.Join(common.ChartPath, "seed-monitoring"), fmt.Sprintf("%s-monitoring", b.Operation.Shoot.SeedNamespace), b.Operation.Shoot.SeedNamespace, nil, values)
 }
 
+// generateAlertReceiverValues computes the Alertmanager receivers which have been declared by the Shoot owner
+// in the monitoring section of the Shoot specification. The credentials of the Slack, PagerDuty and webhook
+// receivers are read from the referenced secrets in the Shoot namespace of the Garden cluster.
+func (b *Botanist) generateAlertReceiverValues() ([]map[string]interface{}, error) {
+	receivers := []map[string]interface{}{}
+
+	monitoring := b.Shoot.Info.Spec.Monitoring
+	if monitoring == nil || monitoring.Alerting == nil {
+		return receivers, nil
+	}
+
+	for _, receiver := range monitoring.Alerting.Receivers {
+		severities := receiver.Severities
+		if len(severities) == 0 {
+			severities = []string{gardenv1beta1.AlertSeverityCritical, gardenv1beta1.AlertSeverityBlocker}
+		}
+
+		values := map[string]interface{}{
+			"name":       fmt.Sprintf("shoot-%s", receiver.Name),
+			"severities": fmt.Sprintf("^(%s)$", strings.Join(severities, "|")),
+		}
+
+		switch {
+		case receiver.Email != nil:
+			alertingSMTPKeys := b.GetSecretKeysOfRole(common.GardenRoleAlertingSMTP)
+			if len(alertingSMTPKeys) == 0 {
+				b.Logger.Warnf("Skipping email alert receiver %s because no alerting SMTP secret is configured", receiver.Name)
+				continue
+			}
+			secret := b.Secrets[alertingSMTPKeys[0]]
+			values["email_configs"] = []map[string]interface{}{
+				{
+					"to":            strings.Join(receiver.Email.To, ", "),
+					"from":          string(secret.Data["from"]),
+					"smarthost":     string(secret.Data["smarthost"]),
+					"auth_username": string(secret.Data["auth_username"]),
+					"auth_identity": string(secret.Data["auth_identity"]),
+					"auth_password": string(secret.Data["auth_password"]),
+				},
+			}
+
+		case receiver.Slack != nil:
+			secret, err := b.K8sGardenClient.GetSecret(b.Shoot.Info.Namespace, receiver.Slack.SecretRef.Name)
+			if err != nil {
+				return nil, err
+			}
+			slackConfig := map[string]interface{}{
+				"api_url":       string(secret.Data[gardenv1beta1.AlertReceiverSecretURLKey]),
+				"send_resolved": true,
+			}
+			if receiver.Slack.Channel != nil {
+				slackConfig["channel"] = *receiver.Slack.Channel
+			}
+			values["slack_configs"] = []map[string]interface{}{slackConfig}
+
+		case receiver.PagerDuty != nil:
+			secret, err := b.K8sGardenClient.GetSecret(b.Shoot.Info.Namespace, receiver.PagerDuty.SecretRef.Name)
+			if err != nil {
+				return nil, err
+			}
+			values["pagerduty_configs"] = []map[string]interface{}{
+				{
+					"routing_key": string(secret.Data[gardenv1beta1.AlertReceiverSecretRoutingKeyKey]),
+				},
+			}
+
+		case receiver.Webhook != nil:
+			secret, err := b.K8sGardenClient.GetSecret(b.Shoot.Info.Namespace, receiver.Webhook.SecretRef.Name)
+			if err != nil {
+				return nil, err
+			}
+			values["webhook_configs"] = []map[string]interface{}{
+				{
+					"url":           string(secret.Data[gardenv1beta1.AlertReceiverSecretURLKey]),
+					"send_resolved": true,
+				},
+			}
+		}
+
+		receivers = append(receivers, values)
+	}
+
+	return receivers, nil
+}
+
+// generateShootRuleValues reads the additional Prometheus rule files from the ConfigMap which is referenced in
+// the monitoring section of the Shoot specification. The rule files are validated again as the ConfigMap might
+// have been changed after the Shoot was admitted. They are prefixed to not collide with the rules of the Gardener.
+func (b *Botanist) generateShootRuleValues() (map[string]string, error) {
+	shootRules := map[string]string{}
+
+	monitoring := b.Shoot.Info.Spec.Monitoring
+	if monitoring == nil || monitoring.Rules == nil {
+		return shootRules, nil
+	}
+
+	configMap, err := b.K8sGardenClient.GetConfigMap(b.Shoot.Info.Namespace, monitoring.Rules.ConfigMapRef.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, data := range configMap.Data {
+		if err := utils.ValidatePrometheusRules(data); err != nil {
+			return nil, fmt.Errorf("config map %s does not contain a valid Prometheus rule file in its %q key: %v", configMap.Name, key, err)
+		}
+		// The alerts of the Shoot owner are labelled so that the Alertmanager does not send them to the operators.
+		labelledData, err := utils.AddPrometheusRuleLabels(data, map[string]string{"origin": "shoot"})
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimSuffix(strings.TrimSuffix(key, ".yaml"), ".yml")
+		shootRules[fmt.Sprintf("shoot-%s.yml", name)] = labelledData
+	}
+
+	return shootRules, nil
+}
+
 // DeleteSeedMonitoring will delete the monitoring stack from the Seed cluster to avoid phantom alerts
 // during the deletion process. More precisely, the Alertmanager and Prometheus StatefulSets will be
 // deleted.
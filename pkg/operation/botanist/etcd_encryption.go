@@ -0,0 +1,254 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// etcdEncryptionGardenSecretSuffix is the suffix of the secret in the project namespace of the Garden cluster which
+// contains a copy of the etcd encryption configuration.
+const etcdEncryptionGardenSecretSuffix = "etcd-encryption"
+
+// encryptionConfiguration is the configuration of the kube-apiserver which defines how resources are encrypted
+// before they are stored in etcd.
+type encryptionConfiguration struct {
+	Kind       string                `json:"kind"`
+	APIVersion string                `json:"apiVersion"`
+	Resources  []encryptionResources `json:"resources"`
+}
+
+// encryptionResources contains a list of resources and the ordered list of providers used to encrypt them. The
+// first provider is used for writing, all providers are tried for reading.
+type encryptionResources struct {
+	Resources []string             `json:"resources"`
+	Providers []encryptionProvider `json:"providers"`
+}
+
+// encryptionProvider is either the AES-CBC provider or the identity provider (which does not encrypt at all).
+type encryptionProvider struct {
+	AESCBC   *aesConfiguration `json:"aescbc,omitempty"`
+	Identity *struct{}         `json:"identity,omitempty"`
+}
+
+// aesConfiguration contains the ordered list of keys of the AES-CBC provider. The first key is used for writing.
+type aesConfiguration struct {
+	Keys []encryptionKey `json:"keys"`
+}
+
+// encryptionKey is a named, base64-encoded 32 byte key.
+type encryptionKey struct {
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+}
+
+// deployEtcdEncryptionSecret takes the <existing> secret containing the encryption configuration of the kube-apiserver
+// (which might be nil), and it creates or updates it. If the secret does not exist in the Seed cluster then the copy
+// stored in the project namespace of the Garden cluster is used. A new key is only generated if no configuration exists
+// at all or if a rotation of the key has been requested (and no other rotation is pending). As long as older keys or the
+// identity provider are part of the configuration, the existing secrets in the Shoot cluster still need to be re-encrypted.
+func (b *Botanist) deployEtcdEncryptionSecret(existing *corev1.Secret) (*corev1.Secret, error) {
+	var config *encryptionConfiguration
+
+	// The Shoot namespace in the Seed cluster might have been lost (e.g., if the Seed cluster is unavailable and the
+	// control plane is restored from an etcd backup elsewhere). The secrets in such a backup can only be decrypted
+	// with the previous keys, hence, a new key must not be generated in this case.
+	if existing == nil {
+		backup, err := b.K8sGardenClient.GetSecret(b.Shoot.Info.Namespace, generateGardenSecretName(b.Shoot.Info.Name, etcdEncryptionGardenSecretSuffix))
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			b.Logger.Info("Restoring the etcd encryption configuration from the Garden cluster")
+			existing = backup
+		}
+	}
+
+	if existing != nil {
+		config = &encryptionConfiguration{}
+		if err := yaml.Unmarshal(existing.Data[common.EtcdEncryptionConfigurationFileName], config); err != nil {
+			return nil, err
+		}
+	}
+
+	if config == nil || (b.Shoot.EtcdEncryptionKeyRotation && !reencryptionPending(config)) {
+		key, err := generateEncryptionKey()
+		if err != nil {
+			return nil, err
+		}
+		config = addEncryptionKey(config, key)
+	}
+
+	// The configuration format has been promoted with Kubernetes 1.13.
+	config.Kind, config.APIVersion = "EncryptionConfig", "v1"
+	k8s113OrHigher, err := utils.CompareVersions(b.Shoot.Info.Spec.Kubernetes.Version, ">=", "1.13")
+	if err != nil {
+		return nil, err
+	}
+	if k8s113OrHigher {
+		config.Kind, config.APIVersion = "EncryptionConfiguration", "apiserver.config.k8s.io/v1"
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	b.Shoot.EtcdEncryptionReencryptionPending = reencryptionPending(config)
+	return b.storeEtcdEncryptionConfiguration(data)
+}
+
+// storeEtcdEncryptionConfiguration stores the encryption configuration <data> in the etcd encryption secret in the Shoot
+// namespace of the Seed cluster, and a copy of it in the project namespace of the Garden cluster. The copy is written
+// first so that the kube-apiserver never encrypts with a key which only exists in the Seed cluster.
+func (b *Botanist) storeEtcdEncryptionConfiguration(data []byte) (*corev1.Secret, error) {
+	secretData := map[string][]byte{common.EtcdEncryptionConfigurationFileName: data}
+	if _, err := b.K8sGardenClient.CreateSecret(b.Shoot.Info.Namespace, generateGardenSecretName(b.Shoot.Info.Name, etcdEncryptionGardenSecretSuffix), corev1.SecretTypeOpaque, secretData, true); err != nil {
+		return nil, err
+	}
+	return b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, common.EtcdEncryptionSecretName, corev1.SecretTypeOpaque, secretData, true)
+}
+
+// ReencryptSecrets re-encrypts all secrets in the Shoot cluster with the current key (by updating them without any
+// changes) if the encryption configuration contains older keys or the identity provider. Afterwards, those are removed
+// from the configuration which becomes effective with the next rollout of the kube-apiserver.
+func (b *Botanist) ReencryptSecrets() error {
+	if !b.Shoot.EtcdEncryptionReencryptionPending {
+		return nil
+	}
+
+	// All kube-apiserver pods must use the current encryption configuration, otherwise secrets might be written
+	// with a key which is about to be removed.
+	podList, err := b.K8sSeedClient.ListPods(b.Shoot.SeedNamespace, metav1.ListOptions{
+		LabelSelector: "app=kubernetes,role=apiserver",
+	})
+	if err != nil {
+		return err
+	}
+	for _, pod := range podList.Items {
+		if pod.Annotations["checksum/secret-"+common.EtcdEncryptionSecretName] != b.CheckSums[common.EtcdEncryptionSecretName] {
+			return fmt.Errorf("kube-apiserver pod %s does not use the current encryption configuration yet", pod.Name)
+		}
+	}
+
+	secretList, err := b.K8sShootClient.ListSecrets(metav1.NamespaceAll, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, secret := range secretList.Items {
+		secretObj := secret
+		if _, err := b.K8sShootClient.UpdateSecretObject(&secretObj); err != nil {
+			return err
+		}
+	}
+	b.Logger.Infof("Successfully re-encrypted %d secrets", len(secretList.Items))
+
+	existing, err := b.K8sSeedClient.GetSecret(b.Shoot.SeedNamespace, common.EtcdEncryptionSecretName)
+	if err != nil {
+		return err
+	}
+	config := &encryptionConfiguration{}
+	if err := yaml.Unmarshal(existing.Data[common.EtcdEncryptionConfigurationFileName], config); err != nil {
+		return err
+	}
+	config = removeObsoleteEncryptionProviders(config)
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	if _, err := b.storeEtcdEncryptionConfiguration(data); err != nil {
+		return err
+	}
+
+	b.Shoot.EtcdEncryptionReencryptionPending = false
+	b.Shoot.EtcdEncryptionKeyRotated = b.Shoot.EtcdEncryptionKeyRotation
+	return nil
+}
+
+// addEncryptionKey adds the given <key> as first (i.e., writing) key to the AES-CBC provider of the given <config>.
+// If <config> is nil then a new configuration is created which also contains the identity provider (so that
+// secrets which have not been encrypted yet can still be read).
+func addEncryptionKey(config *encryptionConfiguration, key encryptionKey) *encryptionConfiguration {
+	if config == nil || len(config.Resources) == 0 {
+		return &encryptionConfiguration{
+			Resources: []encryptionResources{
+				{
+					Resources: []string{"secrets"},
+					Providers: []encryptionProvider{
+						{AESCBC: &aesConfiguration{Keys: []encryptionKey{key}}},
+						{Identity: &struct{}{}},
+					},
+				},
+			},
+		}
+	}
+
+	for i, provider := range config.Resources[0].Providers {
+		if provider.AESCBC != nil {
+			config.Resources[0].Providers[i].AESCBC.Keys = append([]encryptionKey{key}, provider.AESCBC.Keys...)
+			return config
+		}
+	}
+	config.Resources[0].Providers = append([]encryptionProvider{{AESCBC: &aesConfiguration{Keys: []encryptionKey{key}}}}, config.Resources[0].Providers...)
+	return config
+}
+
+// removeObsoleteEncryptionProviders removes all keys of the AES-CBC provider except the first (i.e., writing) one as well
+// as the identity provider from the given <config>.
+func removeObsoleteEncryptionProviders(config *encryptionConfiguration) *encryptionConfiguration {
+	for i, resources := range config.Resources {
+		var providers []encryptionProvider
+		for _, provider := range resources.Providers {
+			if provider.AESCBC != nil && len(provider.AESCBC.Keys) > 0 {
+				providers = append(providers, encryptionProvider{AESCBC: &aesConfiguration{Keys: provider.AESCBC.Keys[:1]}})
+			}
+		}
+		config.Resources[i].Providers = providers
+	}
+	return config
+}
+
+// reencryptionPending returns true if the given <config> contains more than one key for the AES-CBC provider or the
+// identity provider, i.e. if the secrets in the Shoot cluster still need to be re-encrypted.
+func reencryptionPending(config *encryptionConfiguration) bool {
+	for _, resources := range config.Resources {
+		for _, provider := range resources.Providers {
+			if provider.Identity != nil || (provider.AESCBC != nil && len(provider.AESCBC.Keys) > 1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// generateEncryptionKey generates a new random 32 byte key for the AES-CBC provider.
+func generateEncryptionKey() (encryptionKey, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return encryptionKey{}, err
+	}
+	return encryptionKey{
+		Name:   fmt.Sprintf("key%d", time.Now().Unix()),
+		Secret: utils.EncodeBase64(secret),
+	}, nil
+}
@@ -0,0 +1,251 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"io/ioutil"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/sirupsen/logrus"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("etcd encryption", func() {
+	var (
+		seedClient   *fakeSeedClient
+		gardenClient *fakeSeedClient
+		shootClient  *fakeShootClient
+		botanist     *Botanist
+
+		// providers returns the providers of the encryption configuration stored in the etcd encryption secret, whereas
+		// the AES-CBC provider is represented by the list of its keys.
+		providers = func() []interface{} {
+			secret, ok := seedClient.secrets[common.EtcdEncryptionSecretName]
+			Expect(ok).To(BeTrue())
+
+			config := struct {
+				Kind       string `json:"kind"`
+				APIVersion string `json:"apiVersion"`
+				Resources  []struct {
+					Resources []string `json:"resources"`
+					Providers []struct {
+						AESCBC *struct {
+							Keys []struct {
+								Secret string `json:"secret"`
+							} `json:"keys"`
+						} `json:"aescbc"`
+						Identity *struct{} `json:"identity"`
+					} `json:"providers"`
+				} `json:"resources"`
+			}{}
+			Expect(yaml.Unmarshal(secret.Data[common.EtcdEncryptionConfigurationFileName], &config)).To(Succeed())
+			Expect(config.Resources).To(HaveLen(1))
+			Expect(config.Resources[0].Resources).To(Equal([]string{"secrets"}))
+
+			var result []interface{}
+			for _, provider := range config.Resources[0].Providers {
+				switch {
+				case provider.AESCBC != nil:
+					var keys []string
+					for _, key := range provider.AESCBC.Keys {
+						keys = append(keys, key.Secret)
+					}
+					result = append(result, keys)
+				case provider.Identity != nil:
+					result = append(result, "identity")
+				}
+			}
+			return result
+		}
+
+		deploy = func() {
+			_, err := ExportDeployEtcdEncryptionSecret(botanist, seedClient.secrets[common.EtcdEncryptionSecretName])
+			Expect(err).NotTo(HaveOccurred())
+			// The kube-apiserver is rolled out with the new encryption configuration.
+			botanist.CheckSums[common.EtcdEncryptionSecretName] = string(seedClient.secrets[common.EtcdEncryptionSecretName].Data[common.EtcdEncryptionConfigurationFileName])
+			seedClient.pods = []corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "kube-apiserver",
+						Annotations: map[string]string{"checksum/secret-" + common.EtcdEncryptionSecretName: botanist.CheckSums[common.EtcdEncryptionSecretName]},
+					},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		seedClient = newFakeSeedClient()
+		gardenClient = newFakeSeedClient()
+		shootClient = &fakeShootClient{
+			secrets: []corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "kube-system"}},
+			},
+		}
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				Logger:          logrus.NewEntry(&logrus.Logger{Out: ioutil.Discard}),
+				K8sSeedClient:   seedClient,
+				K8sGardenClient: gardenClient,
+				K8sShootClient:  shootClient,
+				Shoot: &shoot.Shoot{
+					SeedNamespace: "shoot--foo--bar",
+					Info: &gardenv1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+						Spec: gardenv1beta1.ShootSpec{
+							Kubernetes: gardenv1beta1.Kubernetes{Version: "1.12.1"},
+						},
+					},
+				},
+				CheckSums: map[string]string{},
+			},
+		}
+	})
+
+	It("should encrypt with a new key and keep reading unencrypted secrets initially", func() {
+		deploy()
+
+		providers := providers()
+		Expect(providers).To(HaveLen(2))
+		Expect(providers[0]).To(HaveLen(1))
+		Expect(providers[1]).To(Equal("identity"))
+		Expect(botanist.Shoot.EtcdEncryptionReencryptionPending).To(BeTrue())
+	})
+
+	It("should store a copy of the configuration in the project namespace of the Garden cluster", func() {
+		deploy()
+
+		backup, ok := gardenClient.secrets["bar.etcd-encryption"]
+		Expect(ok).To(BeTrue())
+		Expect(backup.Namespace).To(Equal("garden-foo"))
+		Expect(backup.Data).To(Equal(seedClient.secrets[common.EtcdEncryptionSecretName].Data))
+	})
+
+	It("should restore the configuration from the Garden cluster if the secret in the Seed cluster is missing", func() {
+		deploy()
+		Expect(botanist.ReencryptSecrets()).To(Succeed())
+		before := providers()
+		delete(seedClient.secrets, common.EtcdEncryptionSecretName)
+
+		deploy()
+
+		Expect(providers()).To(Equal(before))
+		Expect(botanist.Shoot.EtcdEncryptionReencryptionPending).To(BeFalse())
+	})
+
+	It("should use the configuration format of Kubernetes 1.13 and higher", func() {
+		botanist.Shoot.Info.Spec.Kubernetes.Version = "1.13.0"
+
+		deploy()
+
+		data := string(seedClient.secrets[common.EtcdEncryptionSecretName].Data[common.EtcdEncryptionConfigurationFileName])
+		Expect(data).To(ContainSubstring("apiVersion: apiserver.config.k8s.io/v1"))
+		Expect(data).To(ContainSubstring("kind: EncryptionConfiguration"))
+	})
+
+	It("should remove the identity provider once all secrets have been re-encrypted", func() {
+		deploy()
+		key := providers()[0]
+
+		Expect(botanist.ReencryptSecrets()).To(Succeed())
+
+		Expect(shootClient.updated).To(Equal([]string{"default/foo", "kube-system/bar"}))
+		Expect(providers()).To(Equal([]interface{}{key}))
+		Expect(gardenClient.secrets["bar.etcd-encryption"].Data).To(Equal(seedClient.secrets[common.EtcdEncryptionSecretName].Data))
+		Expect(botanist.Shoot.EtcdEncryptionReencryptionPending).To(BeFalse())
+		Expect(botanist.Shoot.EtcdEncryptionKeyRotated).To(BeFalse())
+	})
+
+	It("should keep the configuration if no re-encryption is pending", func() {
+		deploy()
+		Expect(botanist.ReencryptSecrets()).To(Succeed())
+		shootClient.updated = nil
+		before := providers()
+
+		deploy()
+		Expect(botanist.ReencryptSecrets()).To(Succeed())
+
+		Expect(shootClient.updated).To(BeEmpty())
+		Expect(providers()).To(Equal(before))
+	})
+
+	It("should not re-encrypt the secrets before all kube-apiserver pods use the current configuration", func() {
+		deploy()
+		seedClient.pods[0].Annotations = nil
+
+		Expect(botanist.ReencryptSecrets()).NotTo(Succeed())
+
+		Expect(shootClient.updated).To(BeEmpty())
+		Expect(providers()).To(HaveLen(2))
+		Expect(botanist.Shoot.EtcdEncryptionReencryptionPending).To(BeTrue())
+	})
+
+	Context("key rotation", func() {
+		var oldKey []string
+
+		BeforeEach(func() {
+			deploy()
+			Expect(botanist.ReencryptSecrets()).To(Succeed())
+			shootClient.updated = nil
+			oldKey = providers()[0].([]string)
+
+			botanist.Shoot.EtcdEncryptionKeyRotation = true
+		})
+
+		It("should add a new writing key and keep the old one for reading", func() {
+			deploy()
+
+			providers := providers()
+			Expect(providers).To(HaveLen(1))
+			keys := providers[0].([]string)
+			Expect(keys).To(HaveLen(2))
+			Expect(keys[0]).NotTo(Equal(oldKey[0]))
+			Expect(keys[1]).To(Equal(oldKey[0]))
+			Expect(botanist.Shoot.EtcdEncryptionReencryptionPending).To(BeTrue())
+		})
+
+		It("should not add another key as long as the re-encryption with the new key is pending", func() {
+			deploy()
+			before := providers()
+
+			deploy()
+
+			Expect(providers()).To(Equal(before))
+		})
+
+		It("should remove the old key once all secrets have been re-encrypted", func() {
+			deploy()
+			newKey := providers()[0].([]string)[0]
+
+			Expect(botanist.ReencryptSecrets()).To(Succeed())
+
+			Expect(shootClient.updated).To(Equal([]string{"default/foo", "kube-system/bar"}))
+			Expect(providers()).To(Equal([]interface{}{[]string{newKey}}))
+			Expect(botanist.Shoot.EtcdEncryptionReencryptionPending).To(BeFalse())
+			Expect(botanist.Shoot.EtcdEncryptionKeyRotated).To(BeTrue())
+		})
+	})
+})
@@ -17,5 +17,9 @@
 package botanist
 
 var (
-	ExportGenerateKubeconfig = generateKubeconfig
+	ExportGenerateKubeconfig         = generateKubeconfig
+	ExportComputeCertificates        = computeCertificates
+	ExportGenerateCA                 = (*Botanist).generateCA
+	ExportRotateCA                   = (*Botanist).rotateCA
+	ExportDeployEtcdEncryptionSecret = (*Botanist).deployEtcdEncryptionSecret
 )
@@ -0,0 +1,128 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/kubernetes/mapping"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fakeSeedClient records the operations performed on the Seed (or Garden) cluster. All other methods of the kubernetes.Client
+// interface are not implemented.
+type fakeSeedClient struct {
+	kubernetes.Client
+
+	deployments map[string]*mapping.Deployment
+	secrets     map[string]*corev1.Secret
+	namespaces  map[string]*corev1.Namespace
+	pods        []corev1.Pod
+	scaled      []string
+}
+
+func newFakeSeedClient() *fakeSeedClient {
+	return &fakeSeedClient{
+		deployments: map[string]*mapping.Deployment{},
+		secrets:     map[string]*corev1.Secret{},
+		namespaces:  map[string]*corev1.Namespace{},
+	}
+}
+
+func (c *fakeSeedClient) CreateSecret(namespace, name string, secretType corev1.SecretType, data map[string][]byte, updateIfExists bool) (*corev1.Secret, error) {
+	if _, ok := c.secrets[name]; ok && !updateIfExists {
+		return nil, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, name)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       secretType,
+		Data:       data,
+	}
+	c.secrets[name] = secret
+	return secret, nil
+}
+
+func (c *fakeSeedClient) UpdateSecret(namespace, name string, secretType corev1.SecretType, data map[string][]byte) (*corev1.Secret, error) {
+	if _, ok := c.secrets[name]; !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+	}
+	return c.CreateSecret(namespace, name, secretType, data, true)
+}
+
+func (c *fakeSeedClient) GetSecret(namespace, name string) (*corev1.Secret, error) {
+	if secret, ok := c.secrets[name]; ok {
+		return secret, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+}
+
+func (c *fakeSeedClient) GetNamespace(name string) (*corev1.Namespace, error) {
+	if namespace, ok := c.namespaces[name]; ok {
+		return namespace.DeepCopy(), nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, name)
+}
+
+func (c *fakeSeedClient) UpdateNamespace(namespace *corev1.Namespace) (*corev1.Namespace, error) {
+	if _, ok := c.namespaces[namespace.Name]; !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, namespace.Name)
+	}
+	c.namespaces[namespace.Name] = namespace
+	return namespace, nil
+}
+
+func (c *fakeSeedClient) GetDeployment(namespace, name string) (*mapping.Deployment, error) {
+	if deployment, ok := c.deployments[name]; ok {
+		return deployment, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "deployments"}, name)
+}
+
+func (c *fakeSeedClient) ScaleDeployment(namespace, name string, replicas int32) error {
+	c.scaled = append(c.scaled, fmt.Sprintf("deployment/%s=%d", name, replicas))
+	return nil
+}
+
+func (c *fakeSeedClient) ScaleStatefulSet(namespace, name string, replicas int32) error {
+	c.scaled = append(c.scaled, fmt.Sprintf("statefulset/%s=%d", name, replicas))
+	return nil
+}
+
+func (c *fakeSeedClient) ListPods(namespace string, opts metav1.ListOptions) (*corev1.PodList, error) {
+	return &corev1.PodList{Items: c.pods}, nil
+}
+
+// fakeShootClient records the updates of the secrets in the Shoot cluster. All other methods of the kubernetes.Client
+// interface are not implemented.
+type fakeShootClient struct {
+	kubernetes.Client
+
+	secrets []corev1.Secret
+	updated []string
+}
+
+func (c *fakeShootClient) ListSecrets(namespace string, opts metav1.ListOptions) (*corev1.SecretList, error) {
+	return &corev1.SecretList{Items: c.secrets}, nil
+}
+
+func (c *fakeShootClient) UpdateSecretObject(secret *corev1.Secret) (*corev1.Secret, error) {
+	c.updated = append(c.updated, secret.Namespace+"/"+secret.Name)
+	return secret, nil
+}
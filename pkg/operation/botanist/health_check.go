@@ -16,9 +16,11 @@ package botanist
 
 import (
 	"fmt"
+	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/operation/common"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -27,22 +29,26 @@ import (
 
 // CheckConditionControlPlaneHealthy checks whether the control plane of the Shoot cluster is healthy,
 // i.e. whether all containers running in the relevant namespace in the Seed cluster are healthy.
+// If the Shoot cluster has been hibernated then its control plane is (being) scaled down, hence, neither the
+// kube-apiserver's health nor the completeness of the control plane is checked.
 func (b *Botanist) CheckConditionControlPlaneHealthy(condition *gardenv1beta1.Condition) *gardenv1beta1.Condition {
-	response, err := b.K8sShootClient.Curl("healthz")
-	if err != nil {
-		return helper.ModifyCondition(condition, corev1.ConditionFalse, "KubeAPIServerNotHealthy", fmt.Sprintf("Could not reach Shoot cluster kube-apiserver's /healthz endpoint: '%s'", err.Error()))
-	}
-	var statusCode int
-	response.StatusCode(&statusCode)
-	if statusCode < 200 || statusCode >= 400 {
-		return helper.ModifyCondition(condition, corev1.ConditionFalse, "KubeAPIServerNotHealthy", "Shoot cluster kube-apiserver's /healthz endpoint indicates unhealthiness.")
+	if !b.Shoot.Hibernated {
+		response, err := b.K8sShootClient.Curl("healthz")
+		if err != nil {
+			return helper.ModifyCondition(condition, corev1.ConditionFalse, "KubeAPIServerNotHealthy", fmt.Sprintf("Could not reach Shoot cluster kube-apiserver's /healthz endpoint: '%s'", err.Error()))
+		}
+		var statusCode int
+		response.StatusCode(&statusCode)
+		if statusCode < 200 || statusCode >= 400 {
+			return helper.ModifyCondition(condition, corev1.ConditionFalse, "KubeAPIServerNotHealthy", "Shoot cluster kube-apiserver's /healthz endpoint indicates unhealthiness.")
+		}
 	}
 
 	podList, err := b.K8sSeedClient.ListPods(b.Shoot.SeedNamespace, metav1.ListOptions{})
 	if err != nil {
 		return helper.ModifyCondition(condition, corev1.ConditionUnknown, "FetchPodListFailed", err.Error())
 	}
-	if len(podList.Items) < 6 {
+	if !b.Shoot.Hibernated && len(podList.Items) < 6 {
 		return helper.ModifyCondition(condition, corev1.ConditionFalse, "ControlPlaneIncomplete", "The control plane in the Shoot namespace is incomplete (Pod's are missing).")
 	}
 	for _, pod := range podList.Items {
@@ -56,12 +62,20 @@ func (b *Botanist) CheckConditionControlPlaneHealthy(condition *gardenv1beta1.Co
 		}
 	}
 
+	if b.Shoot.Hibernated {
+		return helper.ModifyCondition(condition, corev1.ConditionTrue, "ControlPlaneHibernated", "Shoot cluster has been hibernated; all remaining pods in the Shoot namespace in the Seed cluster are healthy.")
+	}
 	return helper.ModifyCondition(condition, corev1.ConditionTrue, "AllContainersInRunningState", "All pods running the Shoot namespace in the Seed cluster are healthy.")
 }
 
 // CheckConditionEveryNodeReady checks whether every node registered at the Shoot cluster is in "Ready" state and
 // that no node known to the IaaS is not registered to the Shoot's kube-apiserver.
 func (b *Botanist) CheckConditionEveryNodeReady(condition *gardenv1beta1.Condition) *gardenv1beta1.Condition {
+	// If the cluster has been hibernated then there are no nodes/machines (and the kube-apiserver is not running).
+	if b.Shoot.Hibernated {
+		return helper.ModifyCondition(condition, corev1.ConditionTrue, "ConditionNotChecked", "Shoot cluster has been hibernated.")
+	}
+
 	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
 	if err != nil {
 		return helper.ModifyCondition(condition, corev1.ConditionUnknown, "FetchNodeListFailed", err.Error())
@@ -139,3 +153,64 @@ func (b *Botanist) CheckConditionSystemComponentsHealthy(condition *gardenv1beta
 
 	return helper.ModifyCondition(condition, corev1.ConditionTrue, "AllContainersInKubeSystemInRunningState", "Every container in the kube-system namespace of the Shoot cluster is running.")
 }
+
+// certificateExpiryWarningThreshold is the duration before the expiration of a certificate at which the
+// CertificatesValid condition of a Shoot turns false.
+const certificateExpiryWarningThreshold = 30 * 24 * time.Hour
+
+// CheckConditionCertificatesValid checks whether the certificates generated for the Shoot cluster exist in the Shoot
+// namespace of the Seed cluster and whether none of them is expired or expires within the next 30 days.
+func (b *Botanist) CheckConditionCertificatesValid(condition *gardenv1beta1.Condition) *gardenv1beta1.Condition {
+	secretList, err := b.K8sSeedClient.ListSecrets(b.Shoot.SeedNamespace, metav1.ListOptions{})
+	if err != nil {
+		return helper.ModifyCondition(condition, corev1.ConditionUnknown, "FetchSecretListFailed", err.Error())
+	}
+	existingSecrets := map[string]*corev1.Secret{}
+	for _, secret := range secretList.Items {
+		secretObj := secret
+		existingSecrets[secret.Name] = &secretObj
+	}
+
+	generatedSecrets, err := b.generateSecrets()
+	if err != nil {
+		return helper.ModifyCondition(condition, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, err.Error())
+	}
+	names := []string{"ca"}
+	for _, s := range generatedSecrets {
+		switch secret := s.(type) {
+		case TLSSecret:
+			if !secret.DoNotApply {
+				names = append(names, secret.Name)
+			}
+		case ControlPlaneSecret:
+			if !secret.DoNotApply {
+				names = append(names, secret.Name)
+			}
+		}
+	}
+
+	secrets := map[string]*corev1.Secret{}
+	for _, name := range names {
+		secret, ok := existingSecrets[name]
+		if !ok {
+			return helper.ModifyCondition(condition, corev1.ConditionUnknown, "CertificateNotFound", fmt.Sprintf("Secret %s containing a certificate does not exist (yet).", name))
+		}
+		secrets[name] = secret
+	}
+
+	certificates, err := computeCertificates(secrets)
+	if err != nil {
+		return helper.ModifyCondition(condition, corev1.ConditionFalse, "CertificateInvalid", err.Error())
+	}
+	now := time.Now()
+	for _, certificate := range certificates {
+		if certificate.NotAfter.Time.Before(now) {
+			return helper.ModifyCondition(condition, corev1.ConditionFalse, "CertificateExpired", fmt.Sprintf("Certificate in secret %s has expired at %s.", certificate.Name, certificate.NotAfter.UTC().Format(time.RFC3339)))
+		}
+		if certificate.NotAfter.Time.Before(now.Add(certificateExpiryWarningThreshold)) {
+			return helper.ModifyCondition(condition, corev1.ConditionFalse, "CertificateExpiresSoon", fmt.Sprintf("Certificate in secret %s expires at %s (rotate the certificates by annotating the Shoot with '%s').", certificate.Name, certificate.NotAfter.UTC().Format(time.RFC3339), common.ShootRotateCertificates))
+		}
+	}
+
+	return helper.ModifyCondition(condition, corev1.ConditionTrue, "CertificatesValid", "All certificates of the Shoot cluster are valid for at least 30 days.")
+}
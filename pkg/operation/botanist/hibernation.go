@@ -0,0 +1,133 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+var (
+	// controlPlaneDeploymentNames are the names of the control plane deployments in the Seed cluster which require
+	// a running kube-apiserver. They are scaled down before and scaled up after the kube-apiserver.
+	controlPlaneDeploymentNames = []string{
+		common.KubeAddonManagerDeploymentName,
+		common.MachineControllerManagerDeploymentName,
+		common.KubeControllerManagerDeploymentName,
+		common.KubeSchedulerDeploymentName,
+	}
+	// etcdStatefulSetNames are the names of the etcd StatefulSets in the Seed cluster.
+	etcdStatefulSetNames = []string{
+		common.EtcdMainStatefulSetName,
+		common.EtcdEventsStatefulSetName,
+	}
+)
+
+// IsControlPlaneHibernated checks whether the control plane of a hibernated Shoot cluster has already been scaled
+// down in the Seed cluster, i.e. whether the kube-apiserver deployment exists but has no replicas. In this case,
+// every operation requiring a running kube-apiserver must be skipped. A control plane which has never been deployed
+// is not considered as hibernated (it will be deployed and scaled down afterwards).
+func (b *Botanist) IsControlPlaneHibernated() (bool, error) {
+	if !b.Shoot.Hibernated {
+		return false, nil
+	}
+
+	deployment, err := b.K8sSeedClient.GetDeployment(b.Shoot.SeedNamespace, common.KubeAPIServerDeploymentName)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0, nil
+}
+
+// HibernateControlPlane scales down the entire control plane of the Shoot cluster in the Seed cluster. It must only
+// be called after all machines have been removed. At first, the components which require the kube-apiserver are
+// scaled down, afterwards the kube-apiserver itself, and finally the etcd clusters. The persistent volumes of etcd
+// are kept so that the control plane can be restored on wake-up.
+func (b *Botanist) HibernateControlPlane() error {
+	if err := b.StopControlPlane(); err != nil {
+		return err
+	}
+	for _, name := range etcdStatefulSetNames {
+		if err := b.scaleStatefulSet(name, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StopControlPlane scales down all control plane components of the Shoot cluster in the Seed cluster except etcd. At
+// first, the components which require the kube-apiserver are scaled down, afterwards the kube-apiserver itself. Hence,
+// the data in etcd cannot be modified anymore.
+func (b *Botanist) StopControlPlane() error {
+	for _, name := range controlPlaneDeploymentNames {
+		if err := b.scaleDeployment(name, 0); err != nil {
+			return err
+		}
+	}
+	return b.scaleDeployment(common.KubeAPIServerDeploymentName, 0)
+}
+
+// WakeUpControlPlane scales up the control plane of a hibernated Shoot cluster in the Seed cluster in the reverse
+// order of HibernateControlPlane. It waits until the kube-apiserver is ready before the components depending on it
+// are scaled up. It is used to make the Shoot cluster accessible again without reconciling it, e.g. in order to clean
+// up its resources before it is deleted.
+func (b *Botanist) WakeUpControlPlane(ctx context.Context) error {
+	for _, name := range etcdStatefulSetNames {
+		if err := b.scaleStatefulSet(name, 1); err != nil {
+			return err
+		}
+	}
+	if err := b.scaleDeployment(common.KubeAPIServerDeploymentName, 1); err != nil {
+		return err
+	}
+	if err := b.WaitUntilKubeAPIServerIsReady(ctx); err != nil {
+		return err
+	}
+	for _, name := range controlPlaneDeploymentNames {
+		// The kube-addon-manager would deploy the addons into a cluster without nodes, hence, it stays scaled down.
+		if name == common.KubeAddonManagerDeploymentName {
+			continue
+		}
+		if err := b.scaleDeployment(name, 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ScaleDownKubeAPIServer scales the kube-apiserver deployment of the Shoot cluster in the Seed cluster to zero, e.g.
+// while the data of its etcd is replaced by a backup snapshot. It is scaled up again when it gets deployed.
+func (b *Botanist) ScaleDownKubeAPIServer() error {
+	return b.scaleDeployment(common.KubeAPIServerDeploymentName, 0)
+}
+
+func (b *Botanist) scaleDeployment(name string, replicas int32) error {
+	if err := b.K8sSeedClient.ScaleDeployment(b.Shoot.SeedNamespace, name, replicas); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (b *Botanist) scaleStatefulSet(name string, replicas int32) error {
+	if err := b.K8sSeedClient.ScaleStatefulSet(b.Shoot.SeedNamespace, name, replicas); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
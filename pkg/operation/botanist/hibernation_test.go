@@ -0,0 +1,121 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes/mapping"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/sirupsen/logrus"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("hibernation", func() {
+	var (
+		seedClient *fakeSeedClient
+		botanist   *Botanist
+	)
+
+	BeforeEach(func() {
+		seedClient = newFakeSeedClient()
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				Logger:        logrus.NewEntry(&logrus.Logger{Out: ioutil.Discard}),
+				K8sSeedClient: seedClient,
+				Shoot: &shoot.Shoot{
+					SeedNamespace: "shoot--foo--bar",
+					Hibernated:    true,
+				},
+			},
+		}
+	})
+
+	Describe("#HibernateControlPlane", func() {
+		It("should scale down the dependent components, the kube-apiserver and finally etcd", func() {
+			Expect(botanist.HibernateControlPlane()).To(Succeed())
+			Expect(seedClient.scaled).To(Equal([]string{
+				"deployment/" + common.KubeAddonManagerDeploymentName + "=0",
+				"deployment/" + common.MachineControllerManagerDeploymentName + "=0",
+				"deployment/" + common.KubeControllerManagerDeploymentName + "=0",
+				"deployment/" + common.KubeSchedulerDeploymentName + "=0",
+				"deployment/" + common.KubeAPIServerDeploymentName + "=0",
+				"statefulset/" + common.EtcdMainStatefulSetName + "=0",
+				"statefulset/" + common.EtcdEventsStatefulSetName + "=0",
+			}))
+		})
+	})
+
+	Describe("#WakeUpControlPlane", func() {
+		It("should scale up etcd, the kube-apiserver and finally the dependent components except the kube-addon-manager", func() {
+			seedClient.pods = []corev1.Pod{
+				{
+					Status: corev1.PodStatus{
+						ContainerStatuses: []corev1.ContainerStatus{
+							{Name: common.KubeAPIServerDeploymentName, Ready: true},
+						},
+					},
+				},
+			}
+
+			Expect(botanist.WakeUpControlPlane(context.TODO())).To(Succeed())
+			Expect(seedClient.scaled).To(Equal([]string{
+				"statefulset/" + common.EtcdMainStatefulSetName + "=1",
+				"statefulset/" + common.EtcdEventsStatefulSetName + "=1",
+				"deployment/" + common.KubeAPIServerDeploymentName + "=1",
+				"deployment/" + common.MachineControllerManagerDeploymentName + "=1",
+				"deployment/" + common.KubeControllerManagerDeploymentName + "=1",
+				"deployment/" + common.KubeSchedulerDeploymentName + "=1",
+			}))
+		})
+	})
+
+	Describe("#IsControlPlaneHibernated", func() {
+		var replicas = func(n int32) *mapping.Deployment {
+			return &mapping.Deployment{Spec: mapping.DeploymentSpec{Replicas: &n}}
+		}
+
+		It("should return false if the Shoot is not hibernated", func() {
+			botanist.Shoot.Hibernated = false
+			seedClient.deployments[common.KubeAPIServerDeploymentName] = replicas(0)
+
+			Expect(botanist.IsControlPlaneHibernated()).To(BeFalse())
+		})
+
+		It("should return false if the control plane has never been deployed", func() {
+			Expect(botanist.IsControlPlaneHibernated()).To(BeFalse())
+		})
+
+		It("should return false if the kube-apiserver is still running", func() {
+			seedClient.deployments[common.KubeAPIServerDeploymentName] = replicas(1)
+
+			Expect(botanist.IsControlPlaneHibernated()).To(BeFalse())
+		})
+
+		It("should return true if the kube-apiserver has been scaled down", func() {
+			seedClient.deployments[common.KubeAPIServerDeploymentName] = replicas(0)
+
+			Expect(botanist.IsControlPlaneHibernated()).To(BeTrue())
+		})
+	})
+})
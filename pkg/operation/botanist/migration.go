@@ -0,0 +1,139 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"encoding/json"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// CopySeedNamespace copies the Secrets (e.g., certificates and keys) and the ConfigMaps (e.g., the Terraform states)
+// from the Shoot namespace in the Seed cluster of the <source> Botanist into the Shoot namespace in the Seed cluster
+// of this Botanist. It is used to migrate the control plane of a Shoot cluster to another Seed cluster.
+func (b *Botanist) CopySeedNamespace(source *Botanist) error {
+	secretList, err := source.K8sSeedClient.ListSecrets(source.Shoot.SeedNamespace, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, secret := range secretList.Items {
+		// Service account tokens are generated by the token controller of the Seed cluster.
+		if secret.Type == corev1.SecretTypeServiceAccountToken {
+			continue
+		}
+		if _, err := b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, secret.Name, secret.Type, secret.Data, true); err != nil {
+			return err
+		}
+	}
+
+	configMapList, err := source.K8sSeedClient.ListConfigMaps(source.Shoot.SeedNamespace, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, configMap := range configMapList.Items {
+		// The flow state belongs to the operation which is executed in the source Seed cluster.
+		if configMap.Name == common.FlowStateConfigMapName {
+			continue
+		}
+		if _, err := b.K8sSeedClient.CreateConfigMap(b.Shoot.SeedNamespace, configMap.Name, configMap.Data, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordMigrationSnapshot annotates the Shoot namespace in the Seed cluster with the name of the etcd <snapshot> which
+// has been taken to migrate the control plane to another Seed cluster. It must be called on the Botanist of the source
+// Seed cluster once the content of the namespace has been transferred to the target Seed cluster.
+func (b *Botanist) RecordMigrationSnapshot(snapshot string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespace, err := b.K8sSeedClient.GetNamespace(b.Shoot.SeedNamespace)
+		if err != nil {
+			return err
+		}
+		if namespace.Annotations == nil {
+			namespace.Annotations = map[string]string{}
+		}
+		namespace.Annotations[common.ShootMigrationSnapshot] = snapshot
+		_, err = b.K8sSeedClient.UpdateNamespace(namespace)
+		return err
+	})
+}
+
+// MigrateMachineResources copies the machine classes (of kind <machineClassPlural>), machine deployments, machine sets
+// and machines from the Shoot namespace in the Seed cluster of the <source> Botanist into the Shoot namespace in the
+// Seed cluster of this Botanist. The machine-controller-manager in the target Seed cluster adopts them, hence, the
+// existing nodes of the Shoot cluster are kept. Afterwards, the finalizers of the resources in the source Seed
+// cluster are removed so that they do not block the deletion of the source namespace. It must only be called after
+// the machine-controller-manager in the source Seed cluster has been scaled down, otherwise it would delete the
+// machines.
+func (b *Botanist) MigrateMachineResources(source *Botanist, machineClassPlural string) error {
+	for _, resource := range []string{machineClassPlural, "machinedeployments", "machinesets", "machines"} {
+		var list unstructured.Unstructured
+		if err := source.K8sSeedClient.MachineV1alpha1("GET", resource, source.Shoot.SeedNamespace).Do().Into(&list); err != nil {
+			return err
+		}
+
+		if err := list.EachListItem(func(o runtime.Object) error {
+			obj := o.(*unstructured.Unstructured).DeepCopy()
+			obj.SetNamespace(b.Shoot.SeedNamespace)
+			obj.SetResourceVersion("")
+			obj.SetUID("")
+			obj.SetSelfLink("")
+			obj.SetCreationTimestamp(metav1.Time{})
+			obj.SetGeneration(0)
+			obj.SetOwnerReferences(nil)
+			obj.SetFinalizers(nil)
+
+			body, err := obj.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			if err := b.K8sSeedClient.MachineV1alpha1("POST", resource, b.Shoot.SeedNamespace).Body(body).Do().Error(); err != nil && !apierrors.IsAlreadyExists(err) {
+				return err
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"finalizers": nil,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if err := list.EachListItem(func(o runtime.Object) error {
+			name := o.(*unstructured.Unstructured).GetName()
+			err := source.K8sSeedClient.MachineV1alpha1("PATCH", resource, source.Shoot.SeedNamespace).SetHeader("Content-Type", string(types.MergePatchType)).Name(name).Body(patch).Do().Error()
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"io/ioutil"
+
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/sirupsen/logrus"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("migration", func() {
+	var (
+		seedClient *fakeSeedClient
+		botanist   *Botanist
+	)
+
+	BeforeEach(func() {
+		seedClient = newFakeSeedClient()
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				Logger:        logrus.NewEntry(&logrus.Logger{Out: ioutil.Discard}),
+				K8sSeedClient: seedClient,
+				Shoot:         &shoot.Shoot{SeedNamespace: "shoot--foo--bar"},
+			},
+		}
+	})
+
+	Describe("#RecordMigrationSnapshot", func() {
+		It("should annotate the Shoot namespace with the name of the snapshot", func() {
+			seedClient.namespaces["shoot--foo--bar"] = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "shoot--foo--bar",
+					Annotations: map[string]string{"foo": "bar"},
+				},
+			}
+
+			Expect(botanist.RecordMigrationSnapshot("migration-2")).To(Succeed())
+
+			Expect(seedClient.namespaces["shoot--foo--bar"].Annotations).To(Equal(map[string]string{
+				"foo":                         "bar",
+				common.ShootMigrationSnapshot: "migration-2",
+			}))
+		})
+
+		It("should fail if the Shoot namespace does not exist", func() {
+			Expect(botanist.RecordMigrationSnapshot("migration-2")).NotTo(Succeed())
+		})
+	})
+})
@@ -16,6 +16,9 @@ package botanist
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -24,6 +27,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -96,7 +100,10 @@ type ControlPlaneSecret struct {
 // used by the kube-apiserver, and all client certificates used for communcation. It also creates RSA key
 // pairs for SSH connections to the nodes/VMs and for the VPN tunnel. Moreover, basic authentication
 // credentials are computed which will be used to secure the Ingress resources and the kube-apiserver itself.
-// Server certificates for the exposed monitoring endpoints (via Ingress) are generated as well.
+// Server certificates for the exposed monitoring endpoints (via Ingress) and the encryption configuration for
+// secrets stored in etcd are generated as well.
+// If a certificate rotation has been requested then all certificates are regenerated (and the CA is rotated
+// if required), and the expiration dates of the certificates are recorded on the Shoot object.
 func (b *Botanist) DeploySecrets() error {
 	var (
 		name                  string
@@ -104,7 +111,7 @@ func (b *Botanist) DeploySecrets() error {
 		secretsMap            = map[string]*corev1.Secret{}
 		data                  map[string][]byte
 		basicAuthData         map[string]string
-		CAPrivateKey          *rsa.PrivateKey
+		CAPrivateKey          crypto.Signer
 		CACertificateTemplate *x509.Certificate
 		CACertificatePEM      []byte
 	)
@@ -118,11 +125,27 @@ func (b *Botanist) DeploySecrets() error {
 		secretsMap[secret.ObjectMeta.Name] = &secretObj
 	}
 
+	// Determine whether a rotation of the certificates has been requested.
+	rotateCertificates := false
+	switch b.Shoot.CertificateRotation {
+	case "":
+	case common.CertificateRotationLeaves, common.CertificateRotationCAPrepare, common.CertificateRotationCAComplete:
+		rotateCertificates = true
+	default:
+		return fmt.Errorf("unknown certificate rotation '%s' requested (must be one of '%s', '%s', '%s')", b.Shoot.CertificateRotation, common.CertificateRotationLeaves, common.CertificateRotationCAPrepare, common.CertificateRotationCAComplete)
+	}
+
 	// First we have to generate a CA certificate in order to sign the remainining server/client certificates.
 	name = "ca"
 	if val, ok := secretsMap[name]; ok {
+		if rotateCertificates {
+			val, err = b.rotateCA(val)
+			if err != nil {
+				return err
+			}
+		}
 		b.Secrets[name] = val
-		CAPrivateKey, err = utils.DecodePrivateKey(val.Data["ca.key"])
+		CAPrivateKey, err = utils.DecodeSigner(val.Data["ca.key"])
 		if err != nil {
 			return err
 		}
@@ -130,22 +153,19 @@ func (b *Botanist) DeploySecrets() error {
 		if err != nil {
 			return err
 		}
-		CACertificatePEM, err = signCertificate(CACertificateTemplate, CACertificateTemplate, CAPrivateKey, CAPrivateKey)
-		if err != nil {
-			return err
-		}
+		// The 'ca.crt' key might contain a bundle of the current and the new CA certificate (during a CA rotation).
+		CACertificatePEM = val.Data["ca.crt"]
 	} else {
-		CAPrivateKey, err = generateRSAPrivateKey(2048)
+		CAPrivateKey, CACertificateTemplate, CACertificatePEM, err = b.generateCA()
 		if err != nil {
 			return err
 		}
-		CACertificateTemplate = generateCertificateTemplate("kubernetes", nil, nil, nil, true, false)
-		CACertificatePEM, err = signCertificate(CACertificateTemplate, CACertificateTemplate, CAPrivateKey, CAPrivateKey)
+		CAPrivateKeyPEM, err := utils.EncodeSigner(CAPrivateKey)
 		if err != nil {
 			return err
 		}
 		data = map[string][]byte{
-			"ca.key": utils.EncodePrivateKey(CAPrivateKey),
+			"ca.key": CAPrivateKeyPEM,
 			"ca.crt": CACertificatePEM,
 		}
 		b.Secrets[name], err = b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, name, corev1.SecretTypeOpaque, data, false)
@@ -188,6 +208,12 @@ func (b *Botanist) DeploySecrets() error {
 	}
 	b.Secrets[name] = b.Shoot.Secret
 
+	// Fourth we create the encryption configuration (and key) used by the kube-apiserver to encrypt secrets in etcd.
+	b.Secrets[common.EtcdEncryptionSecretName], err = b.deployEtcdEncryptionSecret(secretsMap[common.EtcdEncryptionSecretName])
+	if err != nil {
+		return err
+	}
+
 	// Now we are prepared enough to generate the remaining secrets, i.e. server certificates, client certificates,
 	// and SSH key pairs.
 	secretList, err := b.generateSecrets()
@@ -216,7 +242,7 @@ func (b *Botanist) DeploySecrets() error {
 			go func() {
 				secretMapLock.Lock()
 				defer secretMapLock.Unlock()
-				if val, ok := secretsMap[secret.Name]; ok {
+				if val, ok := secretsMap[secret.Name]; ok && !rotateCertificates {
 					b.Secrets[secret.Name] = val
 					err = nil
 				} else {
@@ -228,7 +254,7 @@ func (b *Botanist) DeploySecrets() error {
 			go func() {
 				secretMapLock.Lock()
 				defer secretMapLock.Unlock()
-				if val, ok := secretsMap[secret.Name]; ok {
+				if val, ok := secretsMap[secret.Name]; ok && !rotateCertificates {
 					b.Secrets[secret.Name] = val
 					err = nil
 				} else {
@@ -260,22 +286,114 @@ func (b *Botanist) DeploySecrets() error {
 			return err
 		}
 	}
+	// The garden monitoring federates the Prometheus of the Shoot cluster with its client certificate, hence, it is
+	// stored along with the CA certificate (which has signed the certificate of the federation Ingress) in the project
+	// namespace as well.
+	federationData := map[string][]byte{
+		"ca.crt":         b.Secrets["ca"].Data["ca.crt"],
+		"prometheus.crt": b.Secrets["prometheus"].Data["prometheus.crt"],
+		"prometheus.key": b.Secrets["prometheus"].Data["prometheus.key"],
+	}
+	if _, err := b.K8sGardenClient.CreateSecret(b.Shoot.Info.Namespace, generateGardenSecretName(b.Shoot.Info.Name, "monitoring"), corev1.SecretTypeOpaque, federationData, true); err != nil {
+		return err
+	}
+
+	if b.Shoot.Certificates, err = computeCertificates(b.Secrets); err != nil {
+		return err
+	}
+	b.Shoot.CertificatesRotated = rotateCertificates
 
 	return b.computeSecretsCheckSums()
 }
 
+// rotateCA performs the requested phase of a CA rotation on the given <secret> containing the CA of the Shoot
+// cluster. In the first phase a new CA is generated and stored beneath the existing one, and a bundle of both CA
+// certificates is stored in the 'ca.crt' key (the existing CA is still used for signing). In the second phase the
+// new CA replaces the existing one. All other rotation requests leave the CA untouched. The updated Secret object
+// is returned.
+func (b *Botanist) rotateCA(secret *corev1.Secret) (*corev1.Secret, error) {
+	var data map[string][]byte
+
+	switch b.Shoot.CertificateRotation {
+	case common.CertificateRotationCAPrepare:
+		if _, ok := secret.Data["ca-new.crt"]; ok {
+			return secret, nil
+		}
+		newCAPrivateKey, _, newCACertificatePEM, err := b.generateCA()
+		if err != nil {
+			return nil, err
+		}
+		newCAPrivateKeyPEM, err := utils.EncodeSigner(newCAPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		data = map[string][]byte{
+			"ca.key":     secret.Data["ca.key"],
+			"ca.crt":     append(append([]byte{}, secret.Data["ca.crt"]...), newCACertificatePEM...),
+			"ca-new.key": newCAPrivateKeyPEM,
+			"ca-new.crt": newCACertificatePEM,
+		}
+
+	case common.CertificateRotationCAComplete:
+		// Either the CA rotation has already been completed in a previous attempt or it was never prepared. In both cases
+		// the existing CA is kept.
+		if _, ok := secret.Data["ca-new.crt"]; !ok {
+			b.Logger.Infof("No new CA has been prepared, keeping the existing CA (annotate the Shoot with '%s=%s' to prepare a CA rotation)", common.ShootRotateCertificates, common.CertificateRotationCAPrepare)
+			return secret, nil
+		}
+		data = map[string][]byte{
+			"ca.key": secret.Data["ca-new.key"],
+			"ca.crt": secret.Data["ca-new.crt"],
+		}
+
+	default:
+		return secret, nil
+	}
+
+	return b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, secret.Name, corev1.SecretTypeOpaque, data, true)
+}
+
+// computeCertificates takes the map of Secrets <secrets> and returns the expiration dates of all certificates
+// contained in them, sorted by the name of the Secret.
+func computeCertificates(secrets map[string]*corev1.Secret) ([]gardenv1beta1.Certificate, error) {
+	var certificates []gardenv1beta1.Certificate
+
+	for name, secret := range secrets {
+		var certificatePEM []byte
+		switch {
+		case name == "ca":
+			certificatePEM = secret.Data["ca.crt"]
+		case secret.Data["tls.crt"] != nil:
+			certificatePEM = secret.Data["tls.crt"]
+		case secret.Data[name+".crt"] != nil:
+			certificatePEM = secret.Data[name+".crt"]
+		default:
+			continue
+		}
+
+		certificate, err := utils.DecodeCertificate(certificatePEM)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode the certificate of secret '%s': %s", name, err.Error())
+		}
+		certificates = append(certificates, gardenv1beta1.Certificate{
+			Name:     name,
+			NotAfter: metav1.NewTime(certificate.NotAfter),
+		})
+	}
+
+	sort.Slice(certificates, func(i, j int) bool { return certificates[i].Name < certificates[j].Name })
+	return certificates, nil
+}
+
 // DeleteGardenSecrets deletes the Shoot-specific secrets from the project namespace in the Garden cluster.
 // TODO: Switch to putting an ownerReference of the Shoot into the Secret's metadata once garbage collection works properly.
 func (b *Botanist) DeleteGardenSecrets() error {
-	err := b.K8sGardenClient.DeleteSecret(b.Shoot.Info.Namespace, generateGardenSecretName(b.Shoot.Info.Name, "kubeconfig"))
-	if apierrors.IsNotFound(err) {
-		return nil
-	}
-	err = b.K8sGardenClient.DeleteSecret(b.Shoot.Info.Namespace, generateGardenSecretName(b.Shoot.Info.Name, "ssh-keypair"))
-	if apierrors.IsNotFound(err) {
-		return nil
+	for _, key := range []string{"kubeconfig", "ssh-keypair", "monitoring", etcdEncryptionGardenSecretSuffix} {
+		if err := b.K8sGardenClient.DeleteSecret(b.Shoot.Info.Namespace, generateGardenSecretName(b.Shoot.Info.Name, key)); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
 	}
-	return err
+	return nil
 }
 
 // createRSASecret takes a RSASecret object, and it generates a new RSA private key using the specified
@@ -310,11 +428,11 @@ func (b *Botanist) createRSASecret(secret RSASecret) (*corev1.Secret, error) {
 }
 
 // createTLSSecret takes a TLSSecret object, the CA certificate template and the CA private key, and it
-// generates a new 2048-bit RSA private key along with a X509 certificate which will be signed by the given
-// CA. The computed secrets will be created as a Secret object in the Seed cluster and the created Secret
-// object will be returned.
-func (b *Botanist) createTLSSecret(secret TLSSecret, CACertificateTemplate *x509.Certificate, CAPrivateKey *rsa.PrivateKey) (*corev1.Secret, error) {
-	privateKeyPEM, certificatePEM, err := generateCertificate(secret, CACertificateTemplate, CAPrivateKey)
+// generates a new private key (according to the certificate settings of the Shoot) along with a X509 certificate
+// which will be signed by the given CA. The computed secrets will be created as a Secret object in the Seed cluster
+// and the created Secret object will be returned.
+func (b *Botanist) createTLSSecret(secret TLSSecret, CACertificateTemplate *x509.Certificate, CAPrivateKey crypto.Signer) (*corev1.Secret, error) {
+	privateKeyPEM, certificatePEM, err := b.generateCertificate(secret, CACertificateTemplate, CAPrivateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -333,19 +451,19 @@ func (b *Botanist) createTLSSecret(secret TLSSecret, CACertificateTemplate *x509
 			Data: data,
 		}, nil
 	}
-	return b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, secret.Name, corev1.SecretTypeTLS, data, false)
+	return b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, secret.Name, corev1.SecretTypeTLS, data, true)
 }
 
 // createControlPlaneSecret takes a ControlPlaneSecret object, the CA certificate template and the CA private key,
-// the PEM-encoded CA certificate and the basic authentication credentials, and it generates a new 2048-bit RSA
-// private key along with a X509 certificate which will be signed by the given CA. Moreover, depending on the settings
+// the PEM-encoded CA certificate and the basic authentication credentials, and it generates a new private key
+// (according to the certificate settings of the Shoot) along with a X509 certificate which will be signed by the given CA. Moreover, depending on the settings
 // on the secret object, a Kubeconfig with the basic authentication credentials will be created. The computed secrets
 // will be created as a Secret object in the Seed cluster and the created Secret object will be returned.
-func (b *Botanist) createControlPlaneSecret(secret ControlPlaneSecret, CACertificatePEM []byte, CACertificateTemplate *x509.Certificate, CAPrivateKey *rsa.PrivateKey, basicAuthData map[string]string) (*corev1.Secret, error) {
+func (b *Botanist) createControlPlaneSecret(secret ControlPlaneSecret, CACertificatePEM []byte, CACertificateTemplate *x509.Certificate, CAPrivateKey crypto.Signer, basicAuthData map[string]string) (*corev1.Secret, error) {
 	if secret.Name == "kube-apiserver" {
 		secret.IPAddresses, secret.DNSNames = b.appendLoadBalancerIngresses(secret.IPAddresses, secret.DNSNames)
 	}
-	privateKeyPEM, certificatePEM, err := generateCertificate(secret.TLSSecret, CACertificateTemplate, CAPrivateKey)
+	privateKeyPEM, certificatePEM, err := b.generateCertificate(secret.TLSSecret, CACertificateTemplate, CAPrivateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -383,26 +501,58 @@ func (b *Botanist) createControlPlaneSecret(secret ControlPlaneSecret, CACertifi
 			Data: data,
 		}, nil
 	}
-	return b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, secret.Name, corev1.SecretTypeOpaque, data, false)
+	return b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, secret.Name, corev1.SecretTypeOpaque, data, true)
+}
+
+// generateCA generates a new private key (according to the certificate settings of the Shoot) along with a
+// self-signed X509 CA certificate. The private key, the certificate template and the PEM-encoded certificate are
+// returned.
+func (b *Botanist) generateCA() (crypto.Signer, *x509.Certificate, []byte, error) {
+	privateKey, err := b.generatePrivateKey()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	certificateTemplate := generateCertificateTemplate("kubernetes", nil, nil, nil, true, false, b.Shoot.CertificateSettings.CAValidity)
+	certificatePEM, err := signCertificate(certificateTemplate, certificateTemplate, privateKey.Public(), privateKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return privateKey, certificateTemplate, certificatePEM, nil
 }
 
 // generateCertificate takes a TLSSecret object, the CA certificate template and the CA private key, and it
-// generates a new 2048-bit RSA private key along with a X509 certificate which will be signed by the given
-// CA. The private key as well as the certificate will be returned PEM-encoded.
-func generateCertificate(secret TLSSecret, CACertificateTemplate *x509.Certificate, CAPrivateKey *rsa.PrivateKey) ([]byte, []byte, error) {
-	privateKey, err := generateRSAPrivateKey(2048)
+// generates a new private key (according to the certificate settings of the Shoot) along with a X509 certificate
+// which will be signed by the given CA. The private key as well as the certificate will be returned PEM-encoded.
+func (b *Botanist) generateCertificate(secret TLSSecret, CACertificateTemplate *x509.Certificate, CAPrivateKey crypto.Signer) ([]byte, []byte, error) {
+	privateKey, err := b.generatePrivateKey()
 	if err != nil {
 		return nil, nil, err
 	}
-	privateKeyPEM := utils.EncodePrivateKey(privateKey)
-	certificateTemplate := generateCertificateTemplate(secret.CommonName, secret.Organization, secret.DNSNames, secret.IPAddresses, false, secret.IsServerCert)
-	certificatePEM, err := signCertificate(certificateTemplate, CACertificateTemplate, privateKey, CAPrivateKey)
+	privateKeyPEM, err := utils.EncodeSigner(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	certificateTemplate := generateCertificateTemplate(secret.CommonName, secret.Organization, secret.DNSNames, secret.IPAddresses, false, secret.IsServerCert, b.Shoot.CertificateSettings.LeafValidity)
+	certificatePEM, err := signCertificate(certificateTemplate, CACertificateTemplate, privateKey.Public(), CAPrivateKey)
 	if err != nil {
 		return nil, nil, err
 	}
 	return privateKeyPEM, certificatePEM, nil
 }
 
+// generatePrivateKey generates a private key using the key algorithm configured for the Shoot, i.e. either a
+// RSA private key with the configured number of bits or an ECDSA private key using the P-256 curve.
+func (b *Botanist) generatePrivateKey() (crypto.Signer, error) {
+	switch b.Shoot.CertificateSettings.KeyAlgorithm {
+	case common.CertificateKeyAlgorithmRSA:
+		return generateRSAPrivateKey(b.Shoot.CertificateSettings.RSAKeySize)
+	case common.CertificateKeyAlgorithmECDSA:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm '%s'", b.Shoot.CertificateSettings.KeyAlgorithm)
+	}
+}
+
 // generateRSAPrivateKey generates a RSA private for the given number of <bits>.
 func generateRSAPrivateKey(bits int) (*rsa.PrivateKey, error) {
 	return rsa.GenerateKey(rand.Reader, bits)
@@ -423,15 +573,15 @@ func generateRSAPublicKey(privateKey *rsa.PrivateKey) ([]byte, error) {
 // generateCertificateTemplate creates a X509 Certificate object based on the provided information regarding
 // common name, organization, SANs (DNS names and IP addresses). It can create a server certificate if the
 // <isServerCert> flag is true, otherwise it creates client certificate. If <isCACert> is true, then a CA
-// certificate is being created. The certificates a valid for 10 years.
-func generateCertificateTemplate(commonName string, organization, dnsNames []string, ipAddresses []net.IP, isCA, isServerCert bool) *x509.Certificate {
+// certificate is being created. The certificates are valid for the given <validity> period.
+func generateCertificateTemplate(commonName string, organization, dnsNames []string, ipAddresses []net.IP, isCA, isServerCert bool, validity time.Duration) *x509.Certificate {
 	serialNumber, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
 	template := &x509.Certificate{
 		IsCA: isCA,
 		BasicConstraintsValid: true,
 		SerialNumber:          serialNumber,
 		NotBefore:             time.Now(),
-		NotAfter:              time.Now().AddDate(10, 0, 0), // + 10 years
+		NotAfter:              time.Now().Add(validity),
 		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
 		Subject: pkix.Name{
 			CommonName:   commonName,
@@ -453,10 +603,14 @@ func generateCertificateTemplate(commonName string, organization, dnsNames []str
 }
 
 // signCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
-// the first. It also requires the corresponding private keys of both certificates. The created certificate
-// is returned as byte slice.
-func signCertificate(certificateTemplate, certificateTemplateSigner *x509.Certificate, privateKey, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+// the first. It also requires the public key of the first and the private key of the signing certificate.
+// The created certificate is returned as byte slice.
+func signCertificate(certificateTemplate, certificateTemplateSigner *x509.Certificate, publicKey crypto.PublicKey, privateKeySigner crypto.Signer) ([]byte, error) {
+	// Key encipherment is only applicable for RSA keys.
+	if _, ok := publicKey.(*ecdsa.PublicKey); ok {
+		certificateTemplate.KeyUsage &^= x509.KeyUsageKeyEncipherment
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, publicKey, privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
@@ -538,6 +692,7 @@ func (b *Botanist) generateSecrets() ([]interface{}, error) {
 		alertManagerHost = b.Seed.GetIngressFQDN("a", b.Shoot.Info.Name, b.Garden.ProjectName)
 		grafanaHost      = b.Seed.GetIngressFQDN("g", b.Shoot.Info.Name, b.Garden.ProjectName)
 		prometheusHost   = b.Seed.GetIngressFQDN("p", b.Shoot.Info.Name, b.Garden.ProjectName)
+		federationHost   = b.Seed.GetIngressFQDN("pf", b.Shoot.Info.Name, b.Garden.ProjectName)
 	)
 
 	apiServerCertDNSNames := []string{
@@ -834,6 +989,18 @@ func (b *Botanist) generateSecrets() ([]interface{}, error) {
 			IPAddresses:  nil,
 			IsServerCert: true,
 		},
+
+		// Secret definition for prometheus (federation ingress)
+		TLSSecret{
+			Secret: Secret{
+				Name: "prometheus-federation-tls",
+			},
+			CommonName:   "prometheus-federation",
+			Organization: []string{fmt.Sprintf("%s:monitoring:ingress", garden.GroupName)},
+			DNSNames:     []string{federationHost},
+			IPAddresses:  nil,
+			IsServerCert: true,
+		},
 	}
 
 	if b.Shoot.MonocularEnabled() && b.Shoot.Info.Spec.DNS.Domain != nil {
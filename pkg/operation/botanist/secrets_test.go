@@ -15,12 +15,28 @@
 package botanist_test
 
 import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"math/big"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation"
 	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/sirupsen/logrus"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 )
 
@@ -83,6 +99,172 @@ var _ = Describe("garden", func() {
 					})
 				})
 			})
+
+			Describe("#computeCertificates", func() {
+				generateCertificatePEM := func(notAfter time.Time) []byte {
+					privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+					Expect(err).NotTo(HaveOccurred())
+					template := &x509.Certificate{
+						SerialNumber: big.NewInt(1),
+						Subject:      pkix.Name{CommonName: "test"},
+						NotBefore:    time.Now(),
+						NotAfter:     notAfter,
+					}
+					certificate, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+					Expect(err).NotTo(HaveOccurred())
+					return utils.EncodeCertificate(certificate)
+				}
+
+				var (
+					caNotAfter        = time.Now().AddDate(10, 0, 0).Truncate(time.Second)
+					apiServerNotAfter = time.Now().AddDate(1, 0, 0).Truncate(time.Second)
+					tlsNotAfter       = time.Now().AddDate(0, 1, 0).Truncate(time.Second)
+				)
+
+				It("should return the expiration dates of all certificates sorted by name", func() {
+					certificates, err := ExportComputeCertificates(map[string]*corev1.Secret{
+						"ca":                        {Data: map[string][]byte{"ca.crt": generateCertificatePEM(caNotAfter)}},
+						"kube-apiserver":            {Data: map[string][]byte{"kube-apiserver.crt": generateCertificatePEM(apiServerNotAfter)}},
+						"grafana-tls":               {Data: map[string][]byte{"tls.crt": generateCertificatePEM(tlsNotAfter)}},
+						"vpn-ssh-keypair":           {Data: map[string][]byte{"id_rsa": []byte("key")}},
+						"kube-apiserver-basic-auth": {Data: map[string][]byte{"basic_auth.csv": []byte("pass,admin")}},
+					})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(certificates).To(HaveLen(3))
+					Expect(certificates[0].Name).To(Equal("ca"))
+					Expect(certificates[0].NotAfter.Time.Equal(caNotAfter)).To(BeTrue())
+					Expect(certificates[1].Name).To(Equal("grafana-tls"))
+					Expect(certificates[1].NotAfter.Time.Equal(tlsNotAfter)).To(BeTrue())
+					Expect(certificates[2].Name).To(Equal("kube-apiserver"))
+					Expect(certificates[2].NotAfter.Time.Equal(apiServerNotAfter)).To(BeTrue())
+				})
+
+				It("should return an error if a certificate cannot be decoded", func() {
+					_, err := ExportComputeCertificates(map[string]*corev1.Secret{
+						"kube-apiserver": {Data: map[string][]byte{"kube-apiserver.crt": []byte("invalid")}},
+					})
+
+					Expect(err).To(HaveOccurred())
+				})
+			})
+
+			Describe("#rotateCA", func() {
+				var (
+					seedClient *fakeSeedClient
+					botanist   *Botanist
+					caSecret   *corev1.Secret
+				)
+
+				BeforeEach(func() {
+					logger := logrus.New()
+					logger.Out = ioutil.Discard
+
+					seedClient = newFakeSeedClient()
+					botanist = &Botanist{
+						Operation: &operation.Operation{
+							Logger:        logrus.NewEntry(logger),
+							K8sSeedClient: seedClient,
+							Shoot: &shoot.Shoot{
+								SeedNamespace: "shoot--foo--bar",
+								CertificateSettings: shoot.CertificateSettings{
+									KeyAlgorithm: common.CertificateKeyAlgorithmECDSA,
+									CAValidity:   time.Hour,
+								},
+							},
+						},
+					}
+
+					privateKey, _, certificatePEM, err := ExportGenerateCA(botanist)
+					Expect(err).NotTo(HaveOccurred())
+					privateKeyPEM, err := utils.EncodeSigner(privateKey)
+					Expect(err).NotTo(HaveOccurred())
+					caSecret = &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "shoot--foo--bar"},
+						Data: map[string][]byte{
+							"ca.key": privateKeyPEM,
+							"ca.crt": certificatePEM,
+						},
+					}
+				})
+
+				It("should not touch the CA if only the leaf certificates shall be rotated", func() {
+					botanist.Shoot.CertificateRotation = common.CertificateRotationLeaves
+
+					secret, err := ExportRotateCA(botanist, caSecret)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(secret).To(Equal(caSecret))
+					Expect(seedClient.secrets).To(BeEmpty())
+				})
+
+				Context("ca-prepare", func() {
+					BeforeEach(func() {
+						botanist.Shoot.CertificateRotation = common.CertificateRotationCAPrepare
+					})
+
+					It("should add a new CA and store the bundle of both CA certificates", func() {
+						secret, err := ExportRotateCA(botanist, caSecret)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(secret.Data["ca.key"]).To(Equal(caSecret.Data["ca.key"]))
+						Expect(secret.Data["ca-new.key"]).NotTo(BeEmpty())
+						Expect(secret.Data["ca-new.crt"]).NotTo(BeEmpty())
+						Expect(secret.Data["ca.crt"]).To(Equal(append(append([]byte{}, caSecret.Data["ca.crt"]...), secret.Data["ca-new.crt"]...)))
+						Expect(seedClient.secrets).To(HaveKeyWithValue("ca", secret))
+
+						// The existing CA must still be used for signing.
+						signingCertificate, err := utils.DecodeCertificate(secret.Data["ca.crt"])
+						Expect(err).NotTo(HaveOccurred())
+						existingCertificate, err := utils.DecodeCertificate(caSecret.Data["ca.crt"])
+						Expect(err).NotTo(HaveOccurred())
+						Expect(signingCertificate.Equal(existingCertificate)).To(BeTrue())
+					})
+
+					It("should not generate another CA if the rotation has already been prepared", func() {
+						prepared, err := ExportRotateCA(botanist, caSecret)
+						Expect(err).NotTo(HaveOccurred())
+						seedClient.secrets = map[string]*corev1.Secret{}
+
+						secret, err := ExportRotateCA(botanist, prepared)
+
+						Expect(err).NotTo(HaveOccurred())
+						Expect(secret).To(Equal(prepared))
+						Expect(seedClient.secrets).To(BeEmpty())
+						Expect(bytes.Count(secret.Data["ca.crt"], []byte("BEGIN CERTIFICATE"))).To(Equal(2))
+					})
+				})
+
+				Context("ca-complete", func() {
+					BeforeEach(func() {
+						botanist.Shoot.CertificateRotation = common.CertificateRotationCAComplete
+					})
+
+					It("should replace the existing CA by the new CA", func() {
+						botanist.Shoot.CertificateRotation = common.CertificateRotationCAPrepare
+						prepared, err := ExportRotateCA(botanist, caSecret)
+						Expect(err).NotTo(HaveOccurred())
+						botanist.Shoot.CertificateRotation = common.CertificateRotationCAComplete
+
+						secret, err := ExportRotateCA(botanist, prepared)
+
+						Expect(err).NotTo(HaveOccurred())
+						Expect(secret.Data).To(Equal(map[string][]byte{
+							"ca.key": prepared.Data["ca-new.key"],
+							"ca.crt": prepared.Data["ca-new.crt"],
+						}))
+						Expect(seedClient.secrets).To(HaveKeyWithValue("ca", secret))
+					})
+
+					It("should keep the existing CA if no rotation has been prepared", func() {
+						secret, err := ExportRotateCA(botanist, caSecret)
+
+						Expect(err).NotTo(HaveOccurred())
+						Expect(secret).To(Equal(caSecret))
+						Expect(seedClient.secrets).To(BeEmpty())
+					})
+				})
+			})
 		})
 	})
 })
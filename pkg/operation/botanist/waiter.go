@@ -15,20 +15,22 @@
 package botanist
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // WaitUntilKubeAPIServerServiceIsReady waits until the external load balancer of the kube-apiserver has
 // been created (i.e., its ingress information has been updated in the service status).
-func (b *Botanist) WaitUntilKubeAPIServerServiceIsReady() error {
+func (b *Botanist) WaitUntilKubeAPIServerServiceIsReady(ctx context.Context) error {
 	var e error
-	err := wait.PollImmediate(5*time.Second, 600*time.Second, func() (bool, error) {
+	err := utils.PollImmediateWithContext(ctx, 5*time.Second, 600*time.Second, func() (bool, error) {
 		loadBalancerIngress, serviceStatusIngress, err := common.GetLoadBalancerIngress(b.K8sSeedClient, b.Shoot.SeedNamespace, common.KubeAPIServerDeploymentName)
 		if err != nil {
 			e = err
@@ -40,15 +42,18 @@ func (b *Botanist) WaitUntilKubeAPIServerServiceIsReady() error {
 		return true, nil
 	})
 	if err != nil {
-		return e
+		if e != nil {
+			return e
+		}
+		return err
 	}
 	return nil
 }
 
 // WaitUntilKubeAPIServerIsReady waits until the kube-apiserver pod has a condition in its status which
 // marks that it is ready.
-func (b *Botanist) WaitUntilKubeAPIServerIsReady() error {
-	return wait.PollImmediate(5*time.Second, 300*time.Second, func() (bool, error) {
+func (b *Botanist) WaitUntilKubeAPIServerIsReady(ctx context.Context) error {
+	return utils.PollImmediateWithContext(ctx, 5*time.Second, 300*time.Second, func() (bool, error) {
 		podList, err := b.K8sSeedClient.ListPods(b.Shoot.SeedNamespace, metav1.ListOptions{
 			LabelSelector: "app=kubernetes,role=apiserver",
 		})
@@ -71,10 +76,49 @@ func (b *Botanist) WaitUntilKubeAPIServerIsReady() error {
 	})
 }
 
+// WaitUntilEtcdRestored waits until the pod of the 'main' etcd which has been bootstrapped from the backup snapshot
+// identified by <restoreID> is ready. It fails as soon as the restoration itself has failed.
+func (b *Botanist) WaitUntilEtcdRestored(ctx context.Context, restoreID string) error {
+	var e error
+	err := utils.PollImmediateWithContext(ctx, 5*time.Second, 900*time.Second, func() (bool, error) {
+		podList, err := b.K8sSeedClient.ListPods(b.Shoot.SeedNamespace, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("app=etcd-statefulset,role=%s", common.EtcdRoleMain),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		for _, pod := range podList.Items {
+			if pod.Annotations[common.EtcdRestoreID] != restoreID {
+				continue
+			}
+			for _, containerStatus := range pod.Status.InitContainerStatuses {
+				if terminated := containerStatus.LastTerminationState.Terminated; containerStatus.Name == common.EtcdRestoreContainerName && terminated != nil && terminated.ExitCode != 0 {
+					e = fmt.Errorf("the restoration exited with code %d: %s", terminated.ExitCode, terminated.Message)
+				}
+			}
+			for _, containerStatus := range pod.Status.ContainerStatuses {
+				if containerStatus.Name == common.EtcdContainerName && containerStatus.Ready {
+					return true, nil
+				}
+			}
+		}
+		b.Logger.Info("Waiting until the etcd has been restored from the backup snapshot...")
+		return false, nil
+	})
+	if err != nil {
+		if e != nil {
+			return e
+		}
+		return err
+	}
+	return nil
+}
+
 // WaitUntilVPNConnectionExists waits until a port forward connection to the vpn-shoot pod in the kube-system
 // namespace of the Shoot cluster can be established.
-func (b *Botanist) WaitUntilVPNConnectionExists() error {
-	return wait.PollImmediate(5*time.Second, 900*time.Second, func() (bool, error) {
+func (b *Botanist) WaitUntilVPNConnectionExists(ctx context.Context) error {
+	return utils.PollImmediateWithContext(ctx, 5*time.Second, 900*time.Second, func() (bool, error) {
 		var vpnPod *corev1.Pod
 		podList, err := b.K8sShootClient.ListPods(metav1.NamespaceSystem, metav1.ListOptions{
 			LabelSelector: "app=vpn-shoot",
@@ -103,8 +147,8 @@ func (b *Botanist) WaitUntilVPNConnectionExists() error {
 }
 
 // WaitUntilNamespaceDeleted waits until the namespace of the Shoot cluster within the Seed cluster is deleted.
-func (b *Botanist) WaitUntilNamespaceDeleted() error {
-	return wait.PollImmediate(5*time.Second, 900*time.Second, func() (bool, error) {
+func (b *Botanist) WaitUntilNamespaceDeleted(ctx context.Context) error {
+	return utils.PollImmediateWithContext(ctx, 5*time.Second, 900*time.Second, func() (bool, error) {
 		_, err := b.K8sSeedClient.GetNamespace(b.Shoot.SeedNamespace)
 		if err != nil {
 			if apierrors.IsNotFound(err) {
@@ -119,8 +163,8 @@ func (b *Botanist) WaitUntilNamespaceDeleted() error {
 
 // WaitUntilKubeAddonManagerDeleted waits until the kube-addon-manager deployment within the Seed cluster has
 // been deleted.
-func (b *Botanist) WaitUntilKubeAddonManagerDeleted() error {
-	return wait.PollImmediate(5*time.Second, 600*time.Second, func() (bool, error) {
+func (b *Botanist) WaitUntilKubeAddonManagerDeleted(ctx context.Context) error {
+	return utils.PollImmediateWithContext(ctx, 5*time.Second, 600*time.Second, func() (bool, error) {
 		_, err := b.K8sSeedClient.GetDeployment(b.Shoot.SeedNamespace, common.KubeAddonManagerDeploymentName)
 		if err != nil {
 			if apierrors.IsNotFound(err) {
@@ -0,0 +1,104 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"io/ioutil"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/sirupsen/logrus"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("waiter", func() {
+	var (
+		seedClient *fakeSeedClient
+		botanist   *Botanist
+	)
+
+	BeforeEach(func() {
+		seedClient = newFakeSeedClient()
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				Logger:        logrus.NewEntry(&logrus.Logger{Out: ioutil.Discard}),
+				K8sSeedClient: seedClient,
+				Shoot: &shoot.Shoot{
+					SeedNamespace: "shoot--foo--bar",
+				},
+			},
+		}
+	})
+
+	Describe("#WaitUntilEtcdRestored", func() {
+		var etcdPod = func(restoreID string, ready bool, restoreExitCode int32) corev1.Pod {
+			pod := corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{common.EtcdRestoreID: restoreID},
+				},
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: common.EtcdContainerName, Ready: ready},
+					},
+				},
+			}
+			if restoreExitCode != 0 {
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: common.EtcdRestoreContainerName,
+						LastTerminationState: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{ExitCode: restoreExitCode, Message: "snapshot not found"},
+						},
+					},
+				}
+			}
+			return pod
+		}
+
+		It("should succeed once the etcd bootstrapped from the requested snapshot is ready", func() {
+			seedClient.pods = []corev1.Pod{etcdPod("2", true, 0)}
+
+			Expect(botanist.WaitUntilEtcdRestored(context.TODO(), "2")).To(Succeed())
+		})
+
+		It("should not accept a ready etcd belonging to a previous restoration", func() {
+			seedClient.pods = []corev1.Pod{etcdPod("1", true, 0)}
+			ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+			defer cancel()
+
+			Expect(botanist.WaitUntilEtcdRestored(ctx, "2")).To(Equal(context.DeadlineExceeded))
+		})
+
+		It("should report the reason if the restoration has failed", func() {
+			seedClient.pods = []corev1.Pod{etcdPod("2", false, 1)}
+			ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+			defer cancel()
+
+			err := botanist.WaitUntilEtcdRestored(ctx, "2")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("the restoration exited with code 1: snapshot not found"))
+		})
+	})
+})
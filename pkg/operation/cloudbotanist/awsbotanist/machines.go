@@ -68,8 +68,13 @@ func (b *AWSBotanist) GenerateMachineConfig() ([]map[string]interface{}, []opera
 				return nil, nil, err
 			}
 
+			machineImage := b.Shoot.Info.Spec.Cloud.AWS.MachineImage
+			if worker.MachineImage != nil {
+				machineImage = worker.MachineImage
+			}
+
 			machineClassSpec := map[string]interface{}{
-				"ami":                b.Shoot.Info.Spec.Cloud.AWS.MachineImage.AMI,
+				"ami":                machineImage.AMI,
 				"region":             b.Shoot.Info.Spec.Cloud.Region,
 				"machineType":        worker.MachineType,
 				"iamInstanceProfile": stateVariables[iamInstanceProfile],
@@ -98,15 +103,18 @@ func (b *AWSBotanist) GenerateMachineConfig() ([]map[string]interface{}, []opera
 			}
 
 			var (
-				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
+				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.GetKubeletMajorMinorVersion(worker.Name))
 				deploymentName       = fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1)
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, operation.MachineDeployment{
-				Name:      deploymentName,
-				ClassName: className,
-				Replicas:  common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				Name:           deploymentName,
+				ClassName:      className,
+				Replicas:       common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				WorkerName:     worker.Name,
+				MaxSurge:       worker.MaxSurge,
+				MaxUnavailable: worker.MaxUnavailable,
 			})
 
 			machineClassSpec["name"] = className
@@ -58,6 +58,11 @@ func (b *AzureBotanist) GenerateMachineConfig() ([]map[string]interface{}, []ope
 			return nil, nil, err
 		}
 
+		machineImage := b.Shoot.Info.Spec.Cloud.Azure.MachineImage
+		if worker.MachineImage != nil {
+			machineImage = worker.MachineImage
+		}
+
 		machineClassSpec := map[string]interface{}{
 			"region":            b.Shoot.Info.Spec.Cloud.Region,
 			"resourceGroup":     stateVariables[resourceGroupName],
@@ -74,25 +79,28 @@ func (b *AzureBotanist) GenerateMachineConfig() ([]map[string]interface{}, []ope
 			},
 			"machineType": worker.MachineType,
 			"image": map[string]interface{}{
-				"publisher": b.Shoot.Info.Spec.Cloud.Azure.MachineImage.Publisher,
-				"offer":     b.Shoot.Info.Spec.Cloud.Azure.MachineImage.Offer,
-				"sku":       b.Shoot.Info.Spec.Cloud.Azure.MachineImage.SKU,
-				"version":   b.Shoot.Info.Spec.Cloud.Azure.MachineImage.Version,
+				"publisher": machineImage.Publisher,
+				"offer":     machineImage.Offer,
+				"sku":       machineImage.SKU,
+				"version":   machineImage.Version,
 			},
 			"volumeSize":   common.DiskSize(worker.VolumeSize),
 			"sshPublicKey": string(b.Secrets["ssh-keypair"].Data["id_rsa.pub"]),
 		}
 
 		var (
-			machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
+			machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.GetKubeletMajorMinorVersion(worker.Name))
 			deploymentName       = fmt.Sprintf("%s-%s", b.Shoot.SeedNamespace, worker.Name)
 			className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 		)
 
 		machineDeployments = append(machineDeployments, operation.MachineDeployment{
-			Name:      deploymentName,
-			ClassName: className,
-			Replicas:  worker.AutoScalerMax,
+			Name:           deploymentName,
+			ClassName:      className,
+			Replicas:       worker.AutoScalerMax,
+			WorkerName:     worker.Name,
+			MaxSurge:       worker.MaxSurge,
+			MaxUnavailable: worker.MaxUnavailable,
 		})
 
 		machineClassSpec["name"] = className
@@ -16,6 +16,10 @@ package gcpbotanist
 
 import (
 	"fmt"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // GenerateCloudProviderConfig generates the GCE cloud provider config.
@@ -68,7 +72,35 @@ func (b *GCPBotanist) GenerateKubeSchedulerConfig() (map[string]interface{}, err
 }
 
 // GenerateEtcdBackupConfig returns the etcd backup configuration for the etcd Helm chart.
-// TODO: implement backup functionality for GCP
 func (b *GCPBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]interface{}, error) {
-	return nil, nil, nil
+	var (
+		serviceAccountKey = "serviceAccountKey"
+		bucketName        = "bucketName"
+	)
+	stateVariables, err := terraformer.New(b.Operation, common.TerraformerPurposeBackup).GetStateOutputVariables(serviceAccountKey, bucketName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The private key of a Google service account key is the base64 encoded service account JSON document.
+	serviceAccountJSON, err := utils.DecodeBase64(stateVariables[serviceAccountKey])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secretData := map[string][]byte{
+		ServiceAccountJSON: serviceAccountJSON,
+	}
+
+	backupConfigData := map[string]interface{}{
+		"backupIntervalInSecond": b.Shoot.Info.Spec.Backup.IntervalInSecond,
+		"maxBackups":             b.Shoot.Info.Spec.Backup.Maximum,
+		"storageType":            "GCS",
+		"gcs": map[string]interface{}{
+			"gcsBucket": stateVariables[bucketName],
+			"gcsSecret": common.BackupSecretName,
+		},
+	}
+
+	return secretData, backupConfigData, nil
 }
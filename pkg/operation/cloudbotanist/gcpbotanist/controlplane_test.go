@@ -0,0 +1,119 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpbotanist_test
+
+import (
+	"encoding/base64"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/cloudbotanist/gcpbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeSeedClient returns the given Terraform state for every requested ConfigMap. All other methods of the
+// kubernetes.Client interface are not implemented.
+type fakeSeedClient struct {
+	kubernetes.Client
+	state string
+}
+
+func (c *fakeSeedClient) GetConfigMap(namespace, name string) (*corev1.ConfigMap, error) {
+	return &corev1.ConfigMap{Data: map[string]string{"terraform.tfstate": c.state}}, nil
+}
+
+type fakeChartRenderer struct {
+	chartrenderer.ChartRenderer
+}
+
+var _ = Describe("controlplane", func() {
+	Describe("#GenerateEtcdBackupConfig", func() {
+		var (
+			serviceAccountJSON = `{"project_id":"my-project"}`
+			interval           = 3600
+			maximum            = 5
+
+			newBotanist = func(state string) *GCPBotanist {
+				return &GCPBotanist{
+					Operation: &operation.Operation{
+						K8sSeedClient:     &fakeSeedClient{state: state},
+						ChartSeedRenderer: &fakeChartRenderer{},
+						Shoot: &shoot.Shoot{
+							Info: &gardenv1beta1.Shoot{
+								ObjectMeta: metav1.ObjectMeta{Name: "bar"},
+								Spec: gardenv1beta1.ShootSpec{
+									Backup: &gardenv1beta1.Backup{
+										IntervalInSecond: interval,
+										Maximum:          maximum,
+									},
+								},
+							},
+							SeedNamespace: "shoot--foo--bar",
+						},
+					},
+				}
+			}
+		)
+
+		It("should return the service account and the bucket of the backup infrastructure", func() {
+			botanist := newBotanist(`{"modules":[{"outputs":{
+				"serviceAccountKey":{"value":"` + base64.StdEncoding.EncodeToString([]byte(serviceAccountJSON)) + `"},
+				"bucketName":{"value":"shoot--foo--bar-backup"}}}]}`)
+
+			secretData, backupConfig, err := botanist.GenerateEtcdBackupConfig()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secretData).To(Equal(map[string][]byte{
+				ServiceAccountJSON: []byte(serviceAccountJSON),
+			}))
+			Expect(backupConfig).To(Equal(map[string]interface{}{
+				"backupIntervalInSecond": interval,
+				"maxBackups":             maximum,
+				"storageType":            "GCS",
+				"gcs": map[string]interface{}{
+					"gcsBucket": "shoot--foo--bar-backup",
+					"gcsSecret": common.BackupSecretName,
+				},
+			}))
+		})
+
+		It("should return an error if the service account key is not base64 encoded", func() {
+			botanist := newBotanist(`{"modules":[{"outputs":{
+				"serviceAccountKey":{"value":"%invalid%"},
+				"bucketName":{"value":"shoot--foo--bar-backup"}}}]}`)
+
+			_, _, err := botanist.GenerateEtcdBackupConfig()
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error if the backup infrastructure has not been created yet", func() {
+			botanist := newBotanist(`{"modules":[{"outputs":{}}]}`)
+
+			_, _, err := botanist.GenerateEtcdBackupConfig()
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
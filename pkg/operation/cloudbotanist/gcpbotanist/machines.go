@@ -59,6 +59,25 @@ func (b *GCPBotanist) GenerateMachineConfig() ([]map[string]interface{}, []opera
 				return nil, nil, err
 			}
 
+			machineImage := b.Shoot.Info.Spec.Cloud.GCP.MachineImage
+			if worker.MachineImage != nil {
+				machineImage = worker.MachineImage
+			}
+
+			// Preemptible VMs can neither be restarted automatically nor live-migrated by GCE.
+			scheduling := map[string]interface{}{
+				"automaticRestart":  true,
+				"onHostMaintenance": "MIGRATE",
+				"preemptible":       false,
+			}
+			if worker.Preemptible != nil && *worker.Preemptible {
+				scheduling = map[string]interface{}{
+					"automaticRestart":  false,
+					"onHostMaintenance": "TERMINATE",
+					"preemptible":       true,
+				}
+			}
+
 			machineClassSpec := map[string]interface{}{
 				"region":             b.Shoot.Info.Spec.Cloud.Region,
 				"zone":               zone,
@@ -71,7 +90,7 @@ func (b *GCPBotanist) GenerateMachineConfig() ([]map[string]interface{}, []opera
 						"boot":       true,
 						"sizeGb":     common.DiskSize(worker.VolumeSize),
 						"type":       worker.VolumeType,
-						"image":      b.Shoot.Info.Spec.Cloud.GCP.MachineImage.Image,
+						"image":      machineImage.Image,
 						"labels": map[string]interface{}{
 							"name": b.Shoot.Info.Name,
 						},
@@ -86,11 +105,7 @@ func (b *GCPBotanist) GenerateMachineConfig() ([]map[string]interface{}, []opera
 						"subnetwork": stateVariables[subnetNodes],
 					},
 				},
-				"scheduling": map[string]interface{}{
-					"automaticRestart":  true,
-					"onHostMaintenance": "MIGRATE",
-					"preemptible":       false,
-				},
+				"scheduling": scheduling,
 				"secret": map[string]interface{}{
 					"cloudConfig": cloudConfig.FileContent("cloud-config.yaml"),
 				},
@@ -110,15 +125,18 @@ func (b *GCPBotanist) GenerateMachineConfig() ([]map[string]interface{}, []opera
 			}
 
 			var (
-				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
+				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.GetKubeletMajorMinorVersion(worker.Name))
 				deploymentName       = fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1)
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, operation.MachineDeployment{
-				Name:      deploymentName,
-				ClassName: className,
-				Replicas:  common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				Name:           deploymentName,
+				ClassName:      className,
+				Replicas:       common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				WorkerName:     worker.Name,
+				MaxSurge:       worker.MaxSurge,
+				MaxUnavailable: worker.MaxUnavailable,
 			})
 
 			machineClassSpec["name"] = className
@@ -61,7 +61,33 @@ func (b *OpenStackBotanist) GenerateKubeSchedulerConfig() (map[string]interface{
 }
 
 // GenerateEtcdBackupConfig returns the etcd backup configuration for the etcd Helm chart.
-// TODO: implement backup functionality for OpenStack
+// Swift does not support technical users scoped to a single container, hence, the Keystone
+// credentials of the Seed cluster are used to access the container.
 func (b *OpenStackBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]interface{}, error) {
-	return nil, nil, nil
+	containerName := "containerName"
+	stateVariables, err := terraformer.New(b.Operation, common.TerraformerPurposeBackup).GetStateOutputVariables(containerName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secretData := map[string][]byte{
+		"authURL":    []byte(b.Seed.CloudProfile.Spec.OpenStack.KeyStoneURL),
+		"domainName": b.Seed.Secret.Data[DomainName],
+		"tenantName": b.Seed.Secret.Data[TenantName],
+		"username":   b.Seed.Secret.Data[UserName],
+		"password":   b.Seed.Secret.Data[Password],
+		"region":     []byte(b.Seed.Info.Spec.Cloud.Region),
+	}
+
+	backupConfigData := map[string]interface{}{
+		"backupIntervalInSecond": b.Shoot.Info.Spec.Backup.IntervalInSecond,
+		"maxBackups":             b.Shoot.Info.Spec.Backup.Maximum,
+		"storageType":            "Swift",
+		"swift": map[string]interface{}{
+			"swiftContainer": stateVariables[containerName],
+			"swiftSecret":    common.BackupSecretName,
+		},
+	}
+
+	return secretData, backupConfigData, nil
 }
@@ -15,8 +15,11 @@
 package openstackbotanist
 
 import (
+	"fmt"
+
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // DeployInfrastructure kicks off a Terraform job which deploys the infrastructure.
@@ -85,11 +88,46 @@ func (b *OpenStackBotanist) generateTerraformInfraConfig(createRouter bool, rout
 }
 
 // DeployBackupInfrastructure kicks off a Terraform job which creates the infrastructure resources for backup.
+// It sets up the Swift container to store the backups.
 func (b *OpenStackBotanist) DeployBackupInfrastructure() error {
-	return nil
+	return terraformer.
+		New(b.Operation, common.TerraformerPurposeBackup).
+		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
+		DefineConfig("openstack-backup", b.generateTerraformBackupConfig()).
+		Apply()
 }
 
 // DestroyBackupInfrastructure kicks off a Terraform job which destroys the infrastructure for backup.
 func (b *OpenStackBotanist) DestroyBackupInfrastructure() error {
-	return nil
+	return terraformer.
+		New(b.Operation, common.TerraformerPurposeBackup).
+		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
+		Destroy()
+}
+
+// generateTerraformBackupVariablesEnvironment generates the environment containing the credentials which
+// are required to validate/apply/destroy the Terraform configuration. These environment must contain
+// Terraform variables which are prefixed with TF_VAR_.
+func (b *OpenStackBotanist) generateTerraformBackupVariablesEnvironment() []map[string]interface{} {
+	return common.GenerateTerraformVariablesEnvironment(b.Seed.Secret, map[string]string{
+		"USER_NAME": UserName,
+		"PASSWORD":  Password,
+	})
+}
+
+// generateTerraformBackupConfig creates the Terraform variables and the Terraform config (for the backup)
+// and returns them.
+func (b *OpenStackBotanist) generateTerraformBackupConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"openstack": map[string]interface{}{
+			"authURL":    b.Seed.CloudProfile.Spec.OpenStack.KeyStoneURL,
+			"domainName": string(b.Seed.Secret.Data[DomainName]),
+			"tenantName": string(b.Seed.Secret.Data[TenantName]),
+			"region":     b.Seed.Info.Spec.Cloud.Region,
+		},
+		"container": map[string]interface{}{
+			"name": fmt.Sprintf("%s-%s", b.Shoot.SeedNamespace, utils.ComputeSHA1Hex([]byte(b.Shoot.Info.Status.UID))[:5]),
+		},
+		"clusterName": b.Shoot.SeedNamespace,
+	}
 }
@@ -60,12 +60,17 @@ func (b *OpenStackBotanist) GenerateMachineConfig() ([]map[string]interface{}, [
 				return nil, nil, err
 			}
 
+			machineImage := b.Shoot.Info.Spec.Cloud.OpenStack.MachineImage
+			if worker.MachineImage != nil {
+				machineImage = worker.MachineImage
+			}
+
 			machineClassSpec := map[string]interface{}{
 				"region":           b.Shoot.Info.Spec.Cloud.Region,
 				"availabilityZone": zone,
 				"machineType":      worker.MachineType,
 				"keyName":          stateVariables[keyName],
-				"imageName":        b.Shoot.Info.Spec.Cloud.OpenStack.MachineImage.Image,
+				"imageName":        machineImage.Image,
 				"networkID":        stateVariables[networkID],
 				"securityGroups":   []string{stateVariables[securityGroupName]},
 				"tags": map[string]string{
@@ -78,15 +83,18 @@ func (b *OpenStackBotanist) GenerateMachineConfig() ([]map[string]interface{}, [
 			}
 
 			var (
-				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
+				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.GetKubeletMajorMinorVersion(worker.Name))
 				deploymentName       = fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1)
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, operation.MachineDeployment{
-				Name:      deploymentName,
-				ClassName: className,
-				Replicas:  common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				Name:           deploymentName,
+				ClassName:      className,
+				Replicas:       common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				WorkerName:     worker.Name,
+				MaxSurge:       worker.MaxSurge,
+				MaxUnavailable: worker.MaxUnavailable,
 			})
 
 			machineClassSpec["name"] = className
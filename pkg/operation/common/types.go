@@ -22,6 +22,26 @@ const (
 	// authenticate against the respective cloud provider (required to store the backups of Shoot clusters).
 	BackupSecretName = "etcd-backup"
 
+	// CertificateKeyAlgorithmECDSA is a constant for the ECDSA key algorithm (using the P-256 curve) of the private keys
+	// of the certificates of a Shoot cluster.
+	CertificateKeyAlgorithmECDSA = "ECDSA"
+
+	// CertificateKeyAlgorithmRSA is a constant for the RSA key algorithm of the private keys of the certificates of a
+	// Shoot cluster.
+	CertificateKeyAlgorithmRSA = "RSA"
+
+	// CertificateRotationLeaves is a constant for the value of the ShootRotateCertificates annotation which requests the
+	// regeneration of all leaf certificates with the existing CA.
+	CertificateRotationLeaves = "true"
+
+	// CertificateRotationCAPrepare is a constant for the value of the ShootRotateCertificates annotation which requests the
+	// first phase of a CA rotation, i.e. the creation of a new CA which is trusted in addition to the existing one.
+	CertificateRotationCAPrepare = "ca-prepare"
+
+	// CertificateRotationCAComplete is a constant for the value of the ShootRotateCertificates annotation which requests the
+	// second phase of a CA rotation, i.e. signing all leaf certificates with the new CA and dropping the old one.
+	CertificateRotationCAComplete = "ca-complete"
+
 	// ChartPath is the path to the Helm charts.
 	ChartPath = "charts"
 
@@ -55,12 +75,44 @@ const (
 	// DNS Hosted Zone.
 	DNSHostedZoneID = "dns.garden.sapcloud.io/hostedZoneID"
 
+	// EtcdEncryptionConfigurationFileName is the name of the key in the etcd encryption secret which contains the
+	// encryption configuration of the kube-apiserver.
+	EtcdEncryptionConfigurationFileName = "encryption-configuration.yaml"
+
+	// EtcdEncryptionSecretName is the name of the secret in the Shoot namespace of the Seed cluster which contains the
+	// configuration (and the keys) used by the kube-apiserver to encrypt secrets before storing them in etcd.
+	EtcdEncryptionSecretName = "etcd-encryption-secret"
+
+	// EtcdMainStatefulSetName is the name of the StatefulSet of the etcd cluster storing data about objects in Shoot.
+	EtcdMainStatefulSetName = "etcd-" + EtcdRoleMain
+
+	// EtcdEventsStatefulSetName is the name of the StatefulSet of the etcd cluster storing events in Shoot.
+	EtcdEventsStatefulSetName = "etcd-" + EtcdRoleEvents
+
 	// EtcdRoleMain is the constant defining the role for main etcd storing data about objects in Shoot.
 	EtcdRoleMain = "main"
 
 	// EtcdRoleEvents is the constant defining the role for etcd storing events in Shoot.
 	EtcdRoleEvents = "events"
 
+	// EtcdContainerName is the name of the etcd container in the pods of the etcd StatefulSets.
+	EtcdContainerName = "etcd-container"
+
+	// EtcdRestoreContainerName is the name of the init container which restores the 'main' etcd from a backup snapshot.
+	EtcdRestoreContainerName = "etcd-restore"
+
+	// EtcdRestoreID is a constant for an annotation on the pods of the 'main' etcd which identifies the requested restoration
+	// of a backup snapshot.
+	EtcdRestoreID = "etcd.garden.sapcloud.io/restore-id"
+
+	// EtcdRestoreLatestSnapshot is a constant for the value of the ShootRestoreEtcd annotation which requests the restoration of
+	// the most recent backup snapshot.
+	EtcdRestoreLatestSnapshot = "latest"
+
+	// FlowStateConfigMapName is the name of the ConfigMap in the Shoot namespace of the Seed cluster which stores the
+	// names of the tasks of a flow which have already been executed successfully.
+	FlowStateConfigMapName = "gardener-flow-state"
+
 	// GardenNamespace is the namespace in which the configuration and secrets for
 	// the Gardener controller manager will be stored (e.g., secrets for the Seed clusters).
 	// It is also used by the gardener-apiserver.
@@ -110,6 +162,15 @@ const (
 	// KubeAddonManagerDeploymentName is the name of the kube-addon-manager deployment.
 	KubeAddonManagerDeploymentName = "kube-addon-manager"
 
+	// KubeControllerManagerDeploymentName is the name of the kube-controller-manager deployment.
+	KubeControllerManagerDeploymentName = "kube-controller-manager"
+
+	// KubeSchedulerDeploymentName is the name of the kube-scheduler deployment.
+	KubeSchedulerDeploymentName = "kube-scheduler"
+
+	// MachineControllerManagerDeploymentName is the name of the machine-controller-manager deployment.
+	MachineControllerManagerDeploymentName = "machine-controller-manager"
+
 	// ProjectName is they key of a label on namespaces whose value holds the project name. Usually, the label is set
 	// by the Gardener Dashboard.
 	ProjectName = "project.garden.sapcloud.io/name"
@@ -163,12 +224,63 @@ const (
 	// Shoot Care controller and can be used to easily identify Shoot clusters with issues.
 	ShootUnhealthy = "shoot.garden.sapcloud.io/unhealthy"
 
+	// ShootCertificateKeyAlgorithm is a constant for an annotation on a Shoot resource which may be used to overwrite the
+	// globally configured key algorithm of newly generated certificates. The value must either be 'RSA' or 'ECDSA'.
+	ShootCertificateKeyAlgorithm = "shoot.garden.sapcloud.io/certificate-key-algorithm"
+
+	// ShootCertificateValidity is a constant for an annotation on a Shoot resource which may be used to overwrite the
+	// globally configured validity period of newly generated server and client certificates. The value must be a duration.
+	ShootCertificateValidity = "shoot.garden.sapcloud.io/certificate-validity"
+
+	// ShootHibernationLastCheck is a constant for an annotation on a Shoot resource which contains the time (RFC3339) of the
+	// last check of the hibernation schedules of the Shoot which found an activation. No schedule has been activated between
+	// this time and the last check, hence, activations after this time which have not been applied yet (e.g., because the
+	// controller was not running) are applied with the next check.
+	ShootHibernationLastCheck = "shoot.garden.sapcloud.io/hibernation-last-check"
+
+	// ShootMigrationSnapshot is a constant for an annotation on the Shoot namespace in the source Seed cluster of a control plane
+	// migration which contains the name of the etcd snapshot taken for the migration. It is set once the content of the namespace
+	// has been transferred to the target Seed cluster, hence, later attempts of the migration do not transfer it again.
+	ShootMigrationSnapshot = "shoot.garden.sapcloud.io/migration-snapshot"
+
 	// ShootOperation is a constant for an annotation on a Shoot in a failed state indicating that the operation should be retried.
 	ShootOperation = "shoot.garden.sapcloud.io/operation"
 
+	// ShootRestoreEtcd is a constant for an annotation on a Shoot resource requesting that the main etcd of the Shoot cluster is
+	// restored from a backup snapshot. The value must either be the name of a snapshot or 'latest'. The annotation is removed
+	// once the restoration has succeeded.
+	ShootRestoreEtcd = "shoot.garden.sapcloud.io/restore-etcd"
+
+	// ShootRotateEtcdEncryptionKey is a constant for an annotation on a Shoot resource requesting the rotation of the key
+	// which is used to encrypt secrets in etcd. The value must be 'true'. All existing secrets are re-encrypted with the new
+	// key, and the annotation is removed once the rotation has succeeded.
+	ShootRotateEtcdEncryptionKey = "shoot.garden.sapcloud.io/rotate-etcd-encryption-key"
+
+	// ShootRotateCertificates is a constant for an annotation on a Shoot resource requesting the rotation of the certificates
+	// of the Shoot cluster. The value must be one of 'true' (regenerate all leaf certificates with the existing CA), 'ca-prepare'
+	// (create a new CA and distribute a bundle of the old and the new CA) or 'ca-complete' (sign all leaf certificates with
+	// the new CA and drop the old one). The annotation is removed once the rotation has succeeded.
+	ShootRotateCertificates = "shoot.garden.sapcloud.io/rotate-certificates"
+
 	// ShootSyncPeriod is a constant for an annotation on a Shoot which may be used to overwrite the global Shoot controller sync period.
 	// The value must be a duration. It can also be used to disable the reconciliation at all by setting it to 0m.
 	ShootSyncPeriod = "shoot.garden.sapcloud.io/sync-period"
+
+	// WorkerGroup is a constant for a label on the nodes of a Shoot cluster which contains the name of the worker group
+	// the node belongs to.
+	WorkerGroup = "worker.garden.sapcloud.io/group"
+
+	// WorkerManagedLabels is a constant for an annotation on the nodes of a Shoot cluster which contains the comma-separated
+	// keys of the labels that have been added by Gardener according to the worker group specification.
+	WorkerManagedLabels = "worker.garden.sapcloud.io/managed-labels"
+
+	// WorkerManagedAnnotations is a constant for an annotation on the nodes of a Shoot cluster which contains the comma-separated
+	// keys of the annotations that have been added by Gardener according to the worker group specification.
+	WorkerManagedAnnotations = "worker.garden.sapcloud.io/managed-annotations"
+
+	// WorkerManagedTaints is a constant for an annotation on the nodes of a Shoot cluster which contains the comma-separated
+	// '<key>:<effect>' pairs of the taints that have been added by Gardener according to the worker group specification.
+	WorkerManagedTaints = "worker.garden.sapcloud.io/managed-taints"
 )
 
 // CloudConfigUserDataConfig is a struct containing cloud-specific configuration required to
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -183,3 +184,130 @@ func CheckConfirmationDeletionTimestampValid(objectMeta metav1.ObjectMeta) bool
 	confirmationDeletionTimestamp := metav1.NewTime(timestamp)
 	return confirmationDeletionTimestamp.Equal(deletionTimestamp)
 }
+
+// ApplyWorkerNodeMetadata adds the labels, annotations and taints of the given <worker> group to the <node>. Labels,
+// annotations and taints which have been added by a previous invocation but have been removed from the worker group
+// specification in the meantime are deleted from the node. It returns true if the node has been changed.
+func ApplyWorkerNodeMetadata(node *corev1.Node, worker gardenv1beta1.Worker) bool {
+	var (
+		changed                      = false
+		previouslyManagedLabels      = splitManagedKeys(node.Annotations[WorkerManagedLabels])
+		previouslyManagedAnnotations = splitManagedKeys(node.Annotations[WorkerManagedAnnotations])
+		previouslyManagedTaints      = splitManagedKeys(node.Annotations[WorkerManagedTaints])
+	)
+
+	if node.Labels == nil {
+		node.Labels = map[string]string{}
+	}
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+
+	for _, key := range previouslyManagedLabels {
+		if _, ok := worker.Labels[key]; !ok {
+			if _, exists := node.Labels[key]; exists {
+				delete(node.Labels, key)
+				changed = true
+			}
+		}
+	}
+	for key, value := range worker.Labels {
+		if current, ok := node.Labels[key]; !ok || current != value {
+			node.Labels[key] = value
+			changed = true
+		}
+	}
+
+	for _, key := range previouslyManagedAnnotations {
+		if _, ok := worker.Annotations[key]; !ok {
+			if _, exists := node.Annotations[key]; exists {
+				delete(node.Annotations, key)
+				changed = true
+			}
+		}
+	}
+	for key, value := range worker.Annotations {
+		if current, ok := node.Annotations[key]; !ok || current != value {
+			node.Annotations[key] = value
+			changed = true
+		}
+	}
+
+	var (
+		taintKey      = func(taint corev1.Taint) string { return fmt.Sprintf("%s:%s", taint.Key, taint.Effect) }
+		desiredTaints = map[string]corev1.Taint{}
+		removedTaints = map[string]bool{}
+		taints        = []corev1.Taint{}
+	)
+	for _, taint := range worker.Taints {
+		desiredTaints[taintKey(taint)] = taint
+	}
+	for _, key := range previouslyManagedTaints {
+		if _, ok := desiredTaints[key]; !ok {
+			removedTaints[key] = true
+		}
+	}
+	for _, taint := range node.Spec.Taints {
+		key := taintKey(taint)
+		if removedTaints[key] {
+			changed = true
+			continue
+		}
+		if desiredTaint, ok := desiredTaints[key]; ok {
+			if taint.Value != desiredTaint.Value {
+				taint.Value = desiredTaint.Value
+				changed = true
+			}
+			delete(desiredTaints, key)
+		}
+		taints = append(taints, taint)
+	}
+	for _, taint := range worker.Taints {
+		if _, ok := desiredTaints[taintKey(taint)]; ok {
+			taints = append(taints, taint)
+			changed = true
+		}
+	}
+	node.Spec.Taints = taints
+
+	var (
+		managedLabels      = []string{}
+		managedAnnotations = []string{}
+		managedTaints      = []string{}
+	)
+	for key := range worker.Labels {
+		managedLabels = append(managedLabels, key)
+	}
+	for key := range worker.Annotations {
+		managedAnnotations = append(managedAnnotations, key)
+	}
+	for _, taint := range worker.Taints {
+		managedTaints = append(managedTaints, taintKey(taint))
+	}
+	for annotation, keys := range map[string][]string{
+		WorkerManagedLabels:      managedLabels,
+		WorkerManagedAnnotations: managedAnnotations,
+		WorkerManagedTaints:      managedTaints,
+	} {
+		sort.Strings(keys)
+		value := strings.Join(keys, ",")
+		if node.Annotations[annotation] == value {
+			continue
+		}
+		if len(value) == 0 {
+			delete(node.Annotations, annotation)
+		} else {
+			node.Annotations[annotation] = value
+		}
+		changed = true
+	}
+
+	return changed
+}
+
+func splitManagedKeys(value string) []string {
+	if len(value) == 0 {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
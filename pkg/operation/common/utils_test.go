@@ -21,6 +21,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("common", func() {
@@ -160,5 +162,97 @@ var _ = Describe("common", func() {
 				})
 			})
 		})
+
+		Describe("#ApplyWorkerNodeMetadata", func() {
+			var (
+				node   *corev1.Node
+				worker gardenv1beta1.Worker
+			)
+
+			BeforeEach(func() {
+				node = &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-1",
+						Labels: map[string]string{
+							WorkerGroup: "cpu-worker",
+						},
+					},
+					Spec: corev1.NodeSpec{
+						Taints: []corev1.Taint{
+							{
+								Key:    "node.kubernetes.io/not-ready",
+								Effect: corev1.TaintEffectNoExecute,
+							},
+						},
+					},
+				}
+				worker = gardenv1beta1.Worker{
+					Name:        "cpu-worker",
+					Labels:      map[string]string{"dedicated": "db"},
+					Annotations: map[string]string{"example.com/owner": "team-a"},
+					Taints: []corev1.Taint{
+						{
+							Key:    "dedicated",
+							Value:  "db",
+							Effect: corev1.TaintEffectNoSchedule,
+						},
+					},
+				}
+			})
+
+			It("should add the labels, annotations and taints of the worker group", func() {
+				Expect(ApplyWorkerNodeMetadata(node, worker)).To(BeTrue())
+
+				Expect(node.Labels).To(Equal(map[string]string{
+					WorkerGroup: "cpu-worker",
+					"dedicated": "db",
+				}))
+				Expect(node.Annotations).To(Equal(map[string]string{
+					"example.com/owner":      "team-a",
+					WorkerManagedLabels:      "dedicated",
+					WorkerManagedAnnotations: "example.com/owner",
+					WorkerManagedTaints:      "dedicated:NoSchedule",
+				}))
+				Expect(node.Spec.Taints).To(ConsistOf(
+					corev1.Taint{Key: "node.kubernetes.io/not-ready", Effect: corev1.TaintEffectNoExecute},
+					corev1.Taint{Key: "dedicated", Value: "db", Effect: corev1.TaintEffectNoSchedule},
+				))
+			})
+
+			It("should not report a change if the node is up to date", func() {
+				ApplyWorkerNodeMetadata(node, worker)
+
+				Expect(ApplyWorkerNodeMetadata(node, worker)).To(BeFalse())
+			})
+
+			It("should remove previously added metadata but keep foreign metadata", func() {
+				ApplyWorkerNodeMetadata(node, worker)
+				node.Labels["foo"] = "bar"
+
+				worker.Labels = nil
+				worker.Annotations = nil
+				worker.Taints = []corev1.Taint{
+					{
+						Key:    "dedicated",
+						Value:  "cache",
+						Effect: corev1.TaintEffectNoExecute,
+					},
+				}
+
+				Expect(ApplyWorkerNodeMetadata(node, worker)).To(BeTrue())
+
+				Expect(node.Labels).To(Equal(map[string]string{
+					WorkerGroup: "cpu-worker",
+					"foo":       "bar",
+				}))
+				Expect(node.Annotations).To(Equal(map[string]string{
+					WorkerManagedTaints: "dedicated:NoExecute",
+				}))
+				Expect(node.Spec.Taints).To(ConsistOf(
+					corev1.Taint{Key: "node.kubernetes.io/not-ready", Effect: corev1.TaintEffectNoExecute},
+					corev1.Taint{Key: "dedicated", Value: "cache", Effect: corev1.TaintEffectNoExecute},
+				))
+			})
+		})
 	})
 })
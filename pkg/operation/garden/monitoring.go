@@ -0,0 +1,168 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package garden
+
+import (
+	"fmt"
+	"path/filepath"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// DeployMonitoring deploys the garden monitoring into the Garden namespace of the Garden cluster. It comprises a
+// Prometheus which federates selected time series (node readiness, kube-apiserver and etcd health, firing alerts)
+// from the Prometheus of every Shoot cluster, and a Grafana with dashboards for the whole landscape.
+func DeployMonitoring(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.Interface, imageVector imagevector.ImageVector) error {
+	const chartName = "garden-monitoring"
+
+	targets, err := computeFederationTargets(k8sGardenClient, k8sGardenInformers)
+	if err != nil {
+		return err
+	}
+
+	grafanaCredentials, err := computeGrafanaCredentials(k8sGardenClient)
+	if err != nil {
+		return err
+	}
+
+	images := map[string]interface{}{}
+	for _, name := range []string{"prometheus", "configmap-reloader", "grafana", "grafana-watcher"} {
+		image, err := imageVector.FindImage(name, k8sGardenClient.Version())
+		if err != nil {
+			return err
+		}
+		images[name] = image.String()
+	}
+
+	return common.ApplyChart(k8sGardenClient, chartrenderer.New(k8sGardenClient), filepath.Join(common.ChartPath, chartName), chartName, common.GardenNamespace, nil, map[string]interface{}{
+		"images":  images,
+		"grafana": grafanaCredentials,
+		"federation": map[string]interface{}{
+			"targets": targets,
+		},
+	})
+}
+
+// computeGrafanaCredentials returns the credentials of the garden Grafana. They are generated once and read from
+// the existing secret afterwards.
+func computeGrafanaCredentials(k8sGardenClient kubernetes.Client) (map[string]interface{}, error) {
+	secret, err := k8sGardenClient.GetSecret(common.GardenNamespace, "garden-grafana-credentials")
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	user, password := []byte("admin"), []byte(utils.GenerateRandomString(32))
+	if err == nil && len(secret.Data["user"]) > 0 && len(secret.Data["password"]) > 0 {
+		user, password = secret.Data["user"], secret.Data["password"]
+	}
+
+	return map[string]interface{}{
+		"user":     utils.EncodeBase64(user),
+		"password": utils.EncodeBase64(password),
+	}, nil
+}
+
+// computeFederationTargets computes the Prometheus of all Shoot clusters which shall be federated. The Prometheus
+// of a Shoot cluster is reached via its federation ingress in the Seed cluster; the client certificate of the
+// Prometheus and the CA certificate are taken from the monitoring secret of the Shoot in the project namespace. Shoots which have not
+// been scheduled to a Seed, are hibernated or being deleted, or whose monitoring secret has not been created yet are
+// skipped. Shoots whose target cannot be computed are skipped as well (and logged) in order to not prevent the
+// federation of all other Shoots.
+func computeFederationTargets(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.Interface) ([]map[string]interface{}, error) {
+	var (
+		targets      = []map[string]interface{}{}
+		projectNames = map[string]string{}
+	)
+
+	shoots, err := k8sGardenInformers.Shoots().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, shoot := range shoots {
+		if shoot.Spec.Cloud.Seed == nil || shoot.DeletionTimestamp != nil || helper.IsShootHibernated(shoot) {
+			continue
+		}
+
+		target, err := computeFederationTarget(k8sGardenClient, k8sGardenInformers, shoot, projectNames)
+		if err != nil {
+			logger.Logger.Errorf("Skipping federation of Shoot %s/%s: %s", shoot.Namespace, shoot.Name, err.Error())
+			continue
+		}
+		if target != nil {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets, nil
+}
+
+// computeFederationTarget computes the federation target of the given <shoot>. The names of the projects are cached in
+// <projectNames> (keyed by namespace). It returns nil if the monitoring secret of the Shoot does not exist yet.
+// The name of the target is used as prefix of the keys of its certificates in the Prometheus configuration secret;
+// namespace names cannot contain dots, hence, separating the namespace and the name of the Shoot by a dot keeps it
+// unique.
+func computeFederationTarget(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.Interface, shoot *gardenv1beta1.Shoot, projectNames map[string]string) (map[string]interface{}, error) {
+	seed, err := k8sGardenInformers.Seeds().Lister().Get(*shoot.Spec.Cloud.Seed)
+	if err != nil {
+		return nil, err
+	}
+
+	projectName, ok := projectNames[shoot.Namespace]
+	if !ok {
+		garden, err := New(k8sGardenClient, shoot)
+		if err != nil {
+			return nil, err
+		}
+		projectName = garden.ProjectName
+		projectNames[shoot.Namespace] = projectName
+	}
+
+	secret, err := k8sGardenClient.GetSecret(shoot.Namespace, fmt.Sprintf("%s.monitoring", shoot.Name))
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("Skipping federation of Shoot %s/%s as its monitoring secret has not been created yet.", shoot.Namespace, shoot.Name)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"ca.crt", "prometheus.crt", "prometheus.key"} {
+		if len(secret.Data[key]) == 0 {
+			return nil, fmt.Errorf("the monitoring secret does not contain the key %q", key)
+		}
+	}
+
+	return map[string]interface{}{
+		"name":    fmt.Sprintf("%s.%s", shoot.Namespace, shoot.Name),
+		"project": projectName,
+		"shoot":   shoot.Name,
+		"seed":    seed.Name,
+		"host":    (&seedpkg.Seed{Info: seed}).GetIngressFQDN("pf", shoot.Name, projectName),
+		"ca":      utils.EncodeBase64(secret.Data["ca.crt"]),
+		"cert":    utils.EncodeBase64(secret.Data["prometheus.crt"]),
+		"key":     utils.EncodeBase64(secret.Data["prometheus.key"]),
+	}, nil
+}
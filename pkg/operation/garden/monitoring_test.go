@@ -0,0 +1,199 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package garden_test
+
+import (
+	"io/ioutil"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/garden"
+	"github.com/gardener/gardener/pkg/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fakeGardenClient serves the namespaces and secrets of the Garden cluster. All other methods of the
+// kubernetes.Client interface are not implemented.
+type fakeGardenClient struct {
+	kubernetes.Client
+
+	namespaces map[string]*corev1.Namespace
+	secrets    map[string]*corev1.Secret
+}
+
+func (c *fakeGardenClient) GetNamespace(name string) (*corev1.Namespace, error) {
+	if namespace, ok := c.namespaces[name]; ok {
+		return namespace, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, name)
+}
+
+func (c *fakeGardenClient) GetSecret(namespace, name string) (*corev1.Secret, error) {
+	if secret, ok := c.secrets[namespace+"/"+name]; ok {
+		return secret, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+}
+
+var _ = Describe("monitoring", func() {
+	Describe("#computeFederationTargets", func() {
+		var (
+			gardenClient    *fakeGardenClient
+			informerFactory gardeninformers.SharedInformerFactory
+
+			seedName = "aws-eu1"
+
+			newShoot = func(namespace, name string) *gardenv1beta1.Shoot {
+				return &gardenv1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: gardenv1beta1.ShootSpec{
+						Cloud: gardenv1beta1.Cloud{Seed: &seedName},
+					},
+				}
+			}
+			addShoot = func(shoot *gardenv1beta1.Shoot) {
+				Expect(informerFactory.Garden().V1beta1().Shoots().Informer().GetStore().Add(shoot)).To(Succeed())
+			}
+			addMonitoringSecret = func(namespace, name string) {
+				gardenClient.secrets[namespace+"/"+name+".monitoring"] = &corev1.Secret{
+					Data: map[string][]byte{
+						"ca.crt":         []byte("ca"),
+						"prometheus.crt": []byte("cert"),
+						"prometheus.key": []byte("key"),
+					},
+				}
+			}
+			computeTargetNames = func() []string {
+				targets, err := ExportComputeFederationTargets(gardenClient, informerFactory.Garden().V1beta1())
+				Expect(err).NotTo(HaveOccurred())
+
+				names := []string{}
+				for _, target := range targets {
+					names = append(names, target["name"].(string))
+				}
+				return names
+			}
+		)
+
+		BeforeEach(func() {
+			logger.Logger = logger.NewLogger("info")
+			logger.Logger.Out = ioutil.Discard
+
+			gardenClient = &fakeGardenClient{
+				namespaces: map[string]*corev1.Namespace{
+					"garden-dev": {
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "garden-dev",
+							Labels: map[string]string{common.ProjectName: "dev"},
+						},
+					},
+				},
+				secrets: map[string]*corev1.Secret{},
+			}
+			informerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			Expect(informerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&gardenv1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: seedName},
+				Spec:       gardenv1beta1.SeedSpec{IngressDomain: "ingress.seed.example.com"},
+			})).To(Succeed())
+		})
+
+		It("should use the client certificate of the Prometheus of the Shoot", func() {
+			addShoot(newShoot("garden-dev", "foo"))
+			addMonitoringSecret("garden-dev", "foo")
+
+			targets, err := ExportComputeFederationTargets(gardenClient, informerFactory.Garden().V1beta1())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(targets).To(Equal([]map[string]interface{}{
+				{
+					"name":    "garden-dev.foo",
+					"project": "dev",
+					"shoot":   "foo",
+					"seed":    seedName,
+					"host":    "pf.foo.dev.ingress.seed.example.com",
+					"ca":      utils.EncodeBase64([]byte("ca")),
+					"cert":    utils.EncodeBase64([]byte("cert")),
+					"key":     utils.EncodeBase64([]byte("key")),
+				},
+			}))
+		})
+
+		It("should skip Shoots which are not scheduled, hibernated or being deleted", func() {
+			unscheduled := newShoot("garden-dev", "unscheduled")
+			unscheduled.Spec.Cloud.Seed = nil
+			hibernated := newShoot("garden-dev", "hibernated")
+			hibernated.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: true}
+			deleting := newShoot("garden-dev", "deleting")
+			deleting.DeletionTimestamp = &metav1.Time{}
+
+			for _, shoot := range []*gardenv1beta1.Shoot{unscheduled, hibernated, deleting} {
+				addShoot(shoot)
+				addMonitoringSecret(shoot.Namespace, shoot.Name)
+			}
+
+			Expect(computeTargetNames()).To(BeEmpty())
+		})
+
+		It("should skip Shoots whose monitoring secret has not been created yet", func() {
+			addShoot(newShoot("garden-dev", "foo"))
+
+			Expect(computeTargetNames()).To(BeEmpty())
+		})
+
+		It("should skip failing Shoots and continue with the others", func() {
+			unknownSeed := "unknown"
+			withoutSeed := newShoot("garden-dev", "without-seed")
+			withoutSeed.Spec.Cloud.Seed = &unknownSeed
+			withoutProject := newShoot("garden-unknown", "without-project")
+			incompleteSecret := newShoot("garden-dev", "incomplete-secret")
+
+			for _, shoot := range []*gardenv1beta1.Shoot{withoutSeed, withoutProject, incompleteSecret, newShoot("garden-dev", "foo")} {
+				addShoot(shoot)
+				addMonitoringSecret(shoot.Namespace, shoot.Name)
+			}
+			delete(gardenClient.secrets["garden-dev/incomplete-secret.monitoring"].Data, "prometheus.key")
+
+			Expect(computeTargetNames()).To(Equal([]string{"garden-dev.foo"}))
+		})
+
+		It("should compute unique target names", func() {
+			for _, namespace := range []string{"garden-a", "garden-a-b"} {
+				gardenClient.namespaces[namespace] = &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   namespace,
+						Labels: map[string]string{common.ProjectName: strings.TrimPrefix(namespace, "garden-")},
+					},
+				}
+			}
+			for _, shoot := range []*gardenv1beta1.Shoot{newShoot("garden-a", "b-c"), newShoot("garden-a-b", "c")} {
+				addShoot(shoot)
+				addMonitoringSecret(shoot.Namespace, shoot.Name)
+			}
+
+			Expect(computeTargetNames()).To(ConsistOf("garden-a.b-c", "garden-a-b.c"))
+		})
+	})
+})
@@ -59,11 +59,32 @@ func (b *HybridBotanist) generateCloudConfigChart() (*chartrenderer.RenderedChar
 		return nil, err
 	}
 
+	workerSpecs := map[string]gardenv1beta1.Worker{}
+	for _, worker := range b.Shoot.GetWorkers() {
+		workerSpecs[worker.Name] = worker
+	}
+
 	workers := []map[string]interface{}{}
 	for _, workerName := range userDataConfig.WorkerNames {
+		var (
+			worker = workerSpecs[workerName]
+			taints = []map[string]interface{}{}
+		)
+
+		for _, taint := range worker.Taints {
+			taints = append(taints, map[string]interface{}{
+				"key":    taint.Key,
+				"value":  taint.Value,
+				"effect": string(taint.Effect),
+			})
+		}
+
 		workers = append(workers, map[string]interface{}{
-			"name":       workerName,
-			"secretName": b.Shoot.ComputeCloudConfigSecretName(workerName),
+			"name":           workerName,
+			"secretName":     b.Shoot.ComputeCloudConfigSecretName(workerName),
+			"labels":         worker.Labels,
+			"taints":         taints,
+			"kubeletVersion": b.Shoot.GetKubeletVersion(worker),
 		})
 	}
 
@@ -90,7 +111,15 @@ func (b *HybridBotanist) generateCloudConfigChart() (*chartrenderer.RenderedChar
 
 	kubeletConfig := b.Shoot.Info.Spec.Kubernetes.Kubelet
 	if kubeletConfig != nil {
-		config["kubernetes"].(map[string]interface{})["kubelet"].(map[string]interface{})["featureGates"] = kubeletConfig.FeatureGates
+		kubelet := config["kubernetes"].(map[string]interface{})["kubelet"].(map[string]interface{})
+		kubelet["featureGates"] = kubeletConfig.FeatureGates
+		kubelet["cpuManagerPolicy"] = kubeletConfig.CPUManagerPolicy
+		kubelet["evictionHard"] = kubeletConfig.EvictionHard
+		kubelet["evictionSoft"] = kubeletConfig.EvictionSoft
+		kubelet["evictionSoftGracePeriod"] = kubeletConfig.EvictionSoftGracePeriod
+		kubelet["evictionPressureTransitionPeriod"] = kubeletConfig.EvictionPressureTransitionPeriod
+		kubelet["evictionMaxPodGracePeriod"] = kubeletConfig.EvictionMaxPodGracePeriod
+		kubelet["maxPods"] = kubeletConfig.MaxPods
 	}
 
 	if b.Shoot.CloudProfile.Spec.CABundle != nil {
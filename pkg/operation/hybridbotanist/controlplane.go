@@ -15,20 +15,29 @@
 package hybridbotanist
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"strconv"
+	"time"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 var chartPathControlPlane = filepath.Join(common.ChartPath, "seed-controlplane", "charts")
 
 // DeployETCD deploys two etcd clusters via StatefulSets. The first etcd cluster (called 'main') is used for all the
 /// data the Shoot Kubernetes cluster needs to store, whereas the second etcd luster (called 'events') is only used to
-// store the events data. The objectstore is also set up to store the backups.
-func (b *HybridBotanist) DeployETCD() error {
+// store the events data. The objectstore is also set up to store the backups which are periodically taken from the
+// 'main' etcd. If the restoration of a backup snapshot has been requested then the 'main' etcd is bootstrapped from
+// it before the kube-apiserver is started again.
+func (b *HybridBotanist) DeployETCD(ctx context.Context) error {
 	secretData, backupConfigData, err := b.SeedCloudBotanist.GenerateEtcdBackupConfig()
 	if err != nil {
 		return err
@@ -42,27 +51,133 @@ func (b *HybridBotanist) DeployETCD() error {
 		}
 	}
 
-	etcdConfig := map[string]interface{}{}
+	var (
+		restoreSnapshot = b.Shoot.EtcdRestoreSnapshot
+		restoreID       = b.Shoot.EtcdRestoreID
+	)
+	if len(restoreID) == 0 {
+		restoreID = strconv.FormatInt(b.Shoot.Info.Generation, 10)
+	}
 
-	// Some cloud botanists do not yet support backup and won't return backup config data.
-	if backupConfigData != nil {
-		etcdConfig["backup"] = backupConfigData
+	if len(restoreSnapshot) > 0 {
+		// Some cloud botanists do not yet support backup and won't return backup config data.
+		if backupConfigData == nil {
+			return fmt.Errorf("cannot restore etcd from backup snapshot '%s' as no backup is configured for this Shoot", restoreSnapshot)
+		}
+		// The kube-apiserver must not work on the etcd while its data is replaced. It is scaled up again when it gets deployed.
+		if err := b.Botanist.ScaleDownKubeAPIServer(); err != nil {
+			return err
+		}
 	}
 
-	etcd, err := b.Botanist.InjectImages(etcdConfig, b.K8sSeedClient.Version(), map[string]string{"etcd": "etcd"})
+	etcd, err := b.Botanist.InjectImages(map[string]interface{}{}, b.K8sSeedClient.Version(), map[string]string{
+		"etcd":                "etcd",
+		"etcd-backup-restore": "etcd-backup-restore",
+	})
 	if err != nil {
 		return err
 	}
 
 	for _, role := range []string{common.EtcdRoleMain, common.EtcdRoleEvents} {
-		etcd["role"] = role
-		if err := b.ApplyChartSeed(filepath.Join(chartPathControlPlane, "etcd"), fmt.Sprintf("etcd-%s", role), b.Shoot.SeedNamespace, nil, etcd); err != nil {
+		if err := b.ApplyChartSeed(filepath.Join(chartPathControlPlane, "etcd"), fmt.Sprintf("etcd-%s", role), b.Shoot.SeedNamespace, nil, computeEtcdValues(etcd, role, backupConfigData, restoreID, restoreSnapshot)); err != nil {
 			return err
 		}
 	}
+
+	if len(restoreSnapshot) > 0 {
+		if err := b.Botanist.WaitUntilEtcdRestored(ctx, restoreID); err != nil {
+			return fmt.Errorf("failed to restore etcd from backup snapshot '%s': %s", restoreSnapshot, err.Error())
+		}
+		b.Shoot.EtcdRestored = true
+	}
 	return nil
 }
 
+// computeEtcdValues computes the chart values for the etcd with the given <role> based on the given <defaultValues>.
+// Only the 'main' etcd is backed up (if a <backupConfig> is given), hence, only this one can be restored from the
+// backup snapshot <restoreSnapshot>. The restoration is identified by <restoreID>.
+func computeEtcdValues(defaultValues map[string]interface{}, role string, backupConfig map[string]interface{}, restoreID, restoreSnapshot string) map[string]interface{} {
+	values := make(map[string]interface{}, len(defaultValues)+3)
+	for key, value := range defaultValues {
+		values[key] = value
+	}
+	values["role"] = role
+
+	if role != common.EtcdRoleMain || backupConfig == nil {
+		return values
+	}
+	values["backup"] = backupConfig
+
+	if len(restoreSnapshot) > 0 {
+		values["restore"] = map[string]interface{}{
+			"id":       restoreID,
+			"snapshot": restoreSnapshot,
+		}
+	}
+	return values
+}
+
+// SnapshotEtcd takes a snapshot of the 'main' etcd and stores it with the name <snapshot> in the backup infrastructure.
+// It is used to transfer the etcd data when the control plane is migrated to another Seed cluster, hence, the
+// kube-apiserver should be stopped before.
+func (b *HybridBotanist) SnapshotEtcd(ctx context.Context, snapshot string) error {
+	_, backupConfigData, err := b.SeedCloudBotanist.GenerateEtcdBackupConfig()
+	if err != nil {
+		return err
+	}
+	if backupConfigData == nil {
+		return errors.New("cannot take an etcd snapshot as no backup is configured for this Shoot")
+	}
+
+	jobName := fmt.Sprintf("%s-snapshot", common.EtcdMainStatefulSetName)
+	// A Job from a previous attempt must be gone before a new one can be created.
+	if err := b.K8sSeedClient.DeleteJob(b.Shoot.SeedNamespace, jobName); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := utils.PollImmediateWithContext(ctx, 5*time.Second, 300*time.Second, func() (bool, error) {
+		_, err := b.K8sSeedClient.GetJob(b.Shoot.SeedNamespace, jobName)
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}); err != nil {
+		return err
+	}
+
+	values, err := b.Botanist.InjectImages(map[string]interface{}{
+		"role":     common.EtcdRoleMain,
+		"snapshot": snapshot,
+		"backup":   backupConfigData,
+	}, b.K8sSeedClient.Version(), map[string]string{"etcd-backup-restore": "etcd-backup-restore"})
+	if err != nil {
+		return err
+	}
+
+	if err := b.ApplyChartSeed(filepath.Join(chartPathControlPlane, "etcd-snapshot"), "etcd-snapshot", b.Shoot.SeedNamespace, nil, values); err != nil {
+		return err
+	}
+
+	return utils.PollImmediateWithContext(ctx, 5*time.Second, 1800*time.Second, func() (bool, error) {
+		job, err := b.K8sSeedClient.GetJob(b.Shoot.SeedNamespace, jobName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		if job.Status.Succeeded > 0 {
+			return true, nil
+		}
+		for _, condition := range job.Status.Conditions {
+			if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+				return false, fmt.Errorf("taking the etcd snapshot failed: %s", condition.Message)
+			}
+		}
+		b.Logger.Info("Waiting until the etcd snapshot has been taken...")
+		return false, nil
+	})
+}
+
 // DeployCloudProviderConfig asks the Cloud Botanist to provide the cloud specific values for the cloud
 // provider configuration. It will create a ConfigMap for it and store it in the Seed cluster.
 func (b *HybridBotanist) DeployCloudProviderConfig() error {
@@ -113,6 +228,7 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 			"checksum/secret-service-account-key":       b.CheckSums["service-account-key"],
 			"checksum/secret-cloudprovider":             b.CheckSums["cloudprovider"],
 			"checksum/configmap-cloud-provider-config":  b.CheckSums["cloud-provider-config"],
+			"checksum/secret-etcd-encryption-secret":    b.CheckSums[common.EtcdEncryptionSecretName],
 		},
 	}
 	cloudValues, err := b.ShootCloudBotanist.GenerateKubeAPIServerConfig()
@@ -120,16 +236,34 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 		return err
 	}
 
+	var userAdmissionPlugins []gardenv1beta1.AdmissionPlugin
+
 	apiServerConfig := b.Shoot.Info.Spec.Kubernetes.KubeAPIServer
 	if apiServerConfig != nil {
+		userAdmissionPlugins = apiServerConfig.AdmissionPlugins
 		defaultValues["featureGates"] = apiServerConfig.FeatureGates
 		defaultValues["runtimeConfig"] = apiServerConfig.RuntimeConfig
 
 		if apiServerConfig.OIDCConfig != nil {
 			defaultValues["oidcConfig"] = apiServerConfig.OIDCConfig
 		}
+
+		if apiServerConfig.AuditConfig != nil {
+			auditConfig, err := b.generateAuditConfigValues(apiServerConfig.AuditConfig)
+			if err != nil {
+				return err
+			}
+			defaultValues["auditConfig"] = auditConfig
+		}
 	}
 
+	admissionPlugins, disabledAdmissionPlugins, err := computeAdmissionPlugins(b.Shoot.Info.Spec.Kubernetes.Version, userAdmissionPlugins)
+	if err != nil {
+		return err
+	}
+	defaultValues["admissionPlugins"] = admissionPlugins
+	defaultValues["disabledAdmissionPlugins"] = disabledAdmissionPlugins
+
 	values, err := b.Botanist.InjectImages(defaultValues, b.K8sSeedClient.Version(), map[string]string{
 		"hyperkube":         "hyperkube",
 		"vpn-seed":          "vpn-seed",
@@ -142,6 +276,107 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 	return b.ApplyChartSeed(filepath.Join(chartPathControlPlane, name), name, b.Shoot.SeedNamespace, values, cloudValues)
 }
 
+// computeAdmissionPlugins merges the admission plugins configured by the user into the list of admission plugins
+// Gardener enables by default for the given Kubernetes version. It returns the chart values for the enabled plugins
+// (in the order in which they must be executed) and the names of the disabled plugins.
+func computeAdmissionPlugins(kubernetesVersion string, userPlugins []gardenv1beta1.AdmissionPlugin) ([]map[string]interface{}, []string, error) {
+	k8s110OrLater, err := utils.CompareVersions(kubernetesVersion, ">=", "1.10")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defaultPlugins := []string{"NamespaceLifecycle", "LimitRanger", "Initializers", "ServiceAccount", "NodeRestriction", "DefaultStorageClass", "PersistentVolumeLabel", "DefaultTolerationSeconds"}
+	if k8s110OrLater {
+		defaultPlugins = []string{"Initializers", "NamespaceLifecycle", "LimitRanger", "ServiceAccount", "NodeRestriction", "DefaultStorageClass", "PersistentVolumeLabel", "DefaultTolerationSeconds", "StorageObjectInUseProtection", "MutatingAdmissionWebhook", "ValidatingAdmissionWebhook", "PodPreset"}
+	}
+
+	var (
+		names    = defaultPlugins
+		configs  = map[string]*string{}
+		disabled = []string{}
+		values   = []map[string]interface{}{}
+		seen     = map[string]bool{}
+	)
+
+	for _, plugin := range userPlugins {
+		if plugin.Enabled != nil && !*plugin.Enabled {
+			disabled = append(disabled, plugin.Name)
+			continue
+		}
+		configs[plugin.Name] = plugin.Config
+		if plugin.Name != "ResourceQuota" {
+			names = append(names, plugin.Name)
+		}
+	}
+	// The ResourceQuota plugin must always be the last one in the chain (the order matters for Kubernetes versions
+	// < 1.10 where the --admission-control flag determines the execution order).
+	names = append(names, "ResourceQuota")
+
+	for _, name := range names {
+		if seen[name] || utils.ValueExists(name, disabled) {
+			continue
+		}
+		seen[name] = true
+
+		plugin := map[string]interface{}{"name": name}
+		if config := configs[name]; config != nil {
+			plugin["config"] = *config
+		}
+		values = append(values, plugin)
+	}
+
+	return values, disabled, nil
+}
+
+// generateAuditConfigValues reads the audit policy and the webhook kubeconfig referenced in the given audit
+// configuration from the project namespace in the Garden cluster and computes the chart values for the kube-apiserver.
+func (b *HybridBotanist) generateAuditConfigValues(auditConfig *gardenv1beta1.AuditConfig) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+
+	if auditConfig.AuditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
+		configMap, err := b.K8sGardenClient.GetConfigMap(b.Shoot.Info.Namespace, auditConfig.AuditPolicy.ConfigMapRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		policy, ok := configMap.Data[gardenv1beta1.AuditPolicyConfigMapDataKey]
+		if !ok {
+			return nil, fmt.Errorf("config map %s does not contain an audit policy in its %q key", configMap.Name, gardenv1beta1.AuditPolicyConfigMapDataKey)
+		}
+		values["auditPolicy"] = policy
+	}
+
+	if auditConfig.Log != nil {
+		format := gardenv1beta1.AuditLogFormatJSON
+		if auditConfig.Log.Format != nil {
+			format = *auditConfig.Log.Format
+		}
+		values["log"] = map[string]interface{}{
+			"format": format,
+		}
+	}
+
+	if auditConfig.Webhook != nil {
+		secret, err := b.K8sGardenClient.GetSecret(b.Shoot.Info.Namespace, auditConfig.Webhook.KubeconfigSecretRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		kubeconfig, ok := secret.Data[gardenv1beta1.AuditWebhookKubeconfigSecretDataKey]
+		if !ok {
+			return nil, fmt.Errorf("secret %s does not contain a kubeconfig in its %q key", secret.Name, gardenv1beta1.AuditWebhookKubeconfigSecretDataKey)
+		}
+		mode := gardenv1beta1.AuditWebhookModeBatch
+		if auditConfig.Webhook.Mode != nil {
+			mode = *auditConfig.Webhook.Mode
+		}
+		values["webhook"] = map[string]interface{}{
+			"kubeconfig": string(kubeconfig),
+			"mode":       mode,
+		}
+	}
+
+	return values, nil
+}
+
 // DeployKubeControllerManager asks the Cloud Botanist to provide the cloud specific configuration values for the
 // kube-controller-manager deployment.
 func (b *HybridBotanist) DeployKubeControllerManager() error {
@@ -170,6 +405,13 @@ func (b *HybridBotanist) DeployKubeControllerManager() error {
 	controllerManagerConfig := b.Shoot.Info.Spec.Kubernetes.KubeControllerManager
 	if controllerManagerConfig != nil {
 		defaultValues["featureGates"] = controllerManagerConfig.FeatureGates
+
+		if controllerManagerConfig.HorizontalPodAutoscalerConfig != nil {
+			defaultValues["horizontalPodAutoscaler"] = controllerManagerConfig.HorizontalPodAutoscalerConfig
+		}
+		if controllerManagerConfig.NodeMonitorGracePeriod != nil {
+			defaultValues["nodeMonitorGracePeriod"] = controllerManagerConfig.NodeMonitorGracePeriod
+		}
 	}
 
 	values, err := b.Botanist.InjectImages(defaultValues, b.K8sSeedClient.Version(), map[string]string{"hyperkube": "hyperkube"})
@@ -0,0 +1,152 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("controlplane", func() {
+	Describe("#computeEtcdValues", func() {
+		var (
+			defaultValues = map[string]interface{}{
+				"images": map[string]interface{}{"etcd": "etcd:v3"},
+			}
+			backupConfig = map[string]interface{}{
+				"storageType": "S3",
+			}
+		)
+
+		It("should configure the backup of the main etcd", func() {
+			Expect(ExportComputeEtcdValues(defaultValues, "main", backupConfig, "1", "")).To(Equal(map[string]interface{}{
+				"images": defaultValues["images"],
+				"role":   "main",
+				"backup": backupConfig,
+			}))
+		})
+
+		It("should configure the restoration of the main etcd", func() {
+			Expect(ExportComputeEtcdValues(defaultValues, "main", backupConfig, "2", "latest")).To(Equal(map[string]interface{}{
+				"images": defaultValues["images"],
+				"role":   "main",
+				"backup": backupConfig,
+				"restore": map[string]interface{}{
+					"id":       "2",
+					"snapshot": "latest",
+				},
+			}))
+		})
+
+		It("should neither back up nor restore the events etcd", func() {
+			Expect(ExportComputeEtcdValues(defaultValues, "events", backupConfig, "2", "latest")).To(Equal(map[string]interface{}{
+				"images": defaultValues["images"],
+				"role":   "events",
+			}))
+		})
+
+		It("should not configure a backup if none is supported", func() {
+			Expect(ExportComputeEtcdValues(defaultValues, "main", nil, "1", "")).To(Equal(map[string]interface{}{
+				"images": defaultValues["images"],
+				"role":   "main",
+			}))
+		})
+
+		It("should not modify the default values", func() {
+			ExportComputeEtcdValues(defaultValues, "main", backupConfig, "2", "latest")
+
+			Expect(defaultValues).To(HaveLen(1))
+		})
+	})
+
+	Describe("#computeAdmissionPlugins", func() {
+		var (
+			enabled  = true
+			disabled = false
+			config   = "foo: bar"
+
+			names = func(plugins []map[string]interface{}) []string {
+				var result []string
+				for _, plugin := range plugins {
+					result = append(result, plugin["name"].(string))
+				}
+				return result
+			}
+		)
+
+		It("should return the default plugins for Kubernetes 1.10 and higher with ResourceQuota as last plugin", func() {
+			plugins, disabledPlugins, err := ExportComputeAdmissionPlugins("1.10.1", nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names(plugins)).To(Equal([]string{"Initializers", "NamespaceLifecycle", "LimitRanger", "ServiceAccount", "NodeRestriction", "DefaultStorageClass", "PersistentVolumeLabel", "DefaultTolerationSeconds", "StorageObjectInUseProtection", "MutatingAdmissionWebhook", "ValidatingAdmissionWebhook", "PodPreset", "ResourceQuota"}))
+			Expect(disabledPlugins).To(BeEmpty())
+		})
+
+		It("should return the default plugins for Kubernetes versions lower than 1.10 in the order of execution", func() {
+			plugins, _, err := ExportComputeAdmissionPlugins("1.9.6", nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names(plugins)).To(Equal([]string{"NamespaceLifecycle", "LimitRanger", "Initializers", "ServiceAccount", "NodeRestriction", "DefaultStorageClass", "PersistentVolumeLabel", "DefaultTolerationSeconds", "ResourceQuota"}))
+		})
+
+		It("should add the plugins of the user before ResourceQuota and pass their configuration", func() {
+			plugins, _, err := ExportComputeAdmissionPlugins("1.9.6", []gardenv1beta1.AdmissionPlugin{
+				{Name: "ResourceQuota", Config: &config},
+				{Name: "PodNodeSelector", Config: &config},
+				{Name: "AlwaysPullImages", Enabled: &enabled},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plugins[len(plugins)-3:]).To(Equal([]map[string]interface{}{
+				{"name": "PodNodeSelector", "config": config},
+				{"name": "AlwaysPullImages"},
+				{"name": "ResourceQuota", "config": config},
+			}))
+		})
+
+		It("should not duplicate default plugins configured by the user", func() {
+			plugins, _, err := ExportComputeAdmissionPlugins("1.10.1", []gardenv1beta1.AdmissionPlugin{
+				{Name: "LimitRanger", Config: &config},
+				{Name: "PodSecurityPolicy"},
+				{Name: "PodSecurityPolicy"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names(plugins)).To(Equal([]string{"Initializers", "NamespaceLifecycle", "LimitRanger", "ServiceAccount", "NodeRestriction", "DefaultStorageClass", "PersistentVolumeLabel", "DefaultTolerationSeconds", "StorageObjectInUseProtection", "MutatingAdmissionWebhook", "ValidatingAdmissionWebhook", "PodPreset", "PodSecurityPolicy", "ResourceQuota"}))
+			Expect(plugins[2]).To(Equal(map[string]interface{}{"name": "LimitRanger", "config": config}))
+		})
+
+		It("should remove the plugins disabled by the user", func() {
+			plugins, disabledPlugins, err := ExportComputeAdmissionPlugins("1.10.1", []gardenv1beta1.AdmissionPlugin{
+				{Name: "PodPreset", Enabled: &disabled},
+				{Name: "Initializers", Enabled: &disabled},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names(plugins)).NotTo(ContainElement("PodPreset"))
+			Expect(names(plugins)).NotTo(ContainElement("Initializers"))
+			Expect(disabledPlugins).To(Equal([]string{"PodPreset", "Initializers"}))
+		})
+
+		It("should return an error for an invalid Kubernetes version", func() {
+			_, _, err := ExportComputeAdmissionPlugins("foo", nil)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -21,13 +21,17 @@ import (
 	"strings"
 	"time"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 )
 
 var chartPathMachines = filepath.Join(common.ChartPath, "seed-machines", "charts", "machines")
@@ -51,20 +55,37 @@ func (b *HybridBotanist) DeployMachines() error {
 		return fmt.Errorf("Failed to deploy the generated machine classes: '%s'", err.Error())
 	}
 
-	// Generate machien deployment configuration based on previously computed list of deployments.
-	machineDeploymentChartValues, err := b.generateMachineDeploymentConfig(machineDeployments, machineClassKind)
-	if err != nil {
-		return fmt.Errorf("Failed to generate the machine deployment config: '%s'", err.Error())
-	}
+	// Deploy generated machine deployments and wait until they are healthy/available. In case the Shoot is hibernated
+	// we wait until all machines have been removed (the control plane must only be scaled down afterwards).
+	if b.Shoot.Hibernated {
+		if err := b.deployMachineDeployments(machineDeployments, machineClassKind); err != nil {
+			return err
+		}
+		if err := b.waitUntilMachinesDeleted(); err != nil {
+			return fmt.Errorf("Failed while waiting for all machines to be deleted: '%s'", err.Error())
+		}
+	} else {
+		// In case a sequential rollout has been requested, the machine deployments of a worker group are only updated
+		// once all machines of the previous worker groups are available.
+		rollouts := [][]operation.MachineDeployment{machineDeployments}
+		if sequential := b.Shoot.Info.Spec.Cloud.SequentialWorkerRollout; sequential != nil && *sequential {
+			rollouts = groupMachineDeploymentsByWorker(machineDeployments, b.Shoot.GetWorkerNames())
+		}
 
-	// Deploy generated machine deployments.
-	if err := b.ApplyChartSeed(filepath.Join(chartPathMachines), "machines", b.Shoot.SeedNamespace, machineDeploymentChartValues, nil); err != nil {
-		return fmt.Errorf("Failed to deploy the generated machine deployments: '%s'", err.Error())
-	}
+		for _, deployments := range rollouts {
+			if err := b.deployMachineDeployments(deployments, machineClassKind); err != nil {
+				return err
+			}
+			if err := b.waitUntilMachineDeploymentsAvailable(deployments); err != nil {
+				return fmt.Errorf("Failed while waiting for all machine deployments to be ready: '%s'", err.Error())
+			}
+		}
 
-	// Wait until all generated machine deployments are healthy/available.
-	if err := b.waitUntilMachineDeploymentsAvailable(machineDeployments); err != nil {
-		return fmt.Errorf("Failed while waiting for all machine deployments to be ready: '%s'", err.Error())
+		// New nodes register with the labels and taints of their worker group (see the kubelet flags in the cloud config),
+		// however, changes to the worker groups must also be applied to the already existing nodes.
+		if err := b.reconcileNodeMetadata(); err != nil {
+			return fmt.Errorf("Failed to update the labels, annotations and taints of the nodes: '%s'", err.Error())
+		}
 	}
 
 	// Delete all old machine deployments (i.e. those which were not previously computed by exist in the cluster).
@@ -134,19 +155,61 @@ func (b *HybridBotanist) DestroyMachines() error {
 	return nil
 }
 
+// deployMachineDeployments generates the configuration for the given <machineDeployments> and deploys them.
+func (b *HybridBotanist) deployMachineDeployments(machineDeployments []operation.MachineDeployment, classKind string) error {
+	// Generate machine deployment configuration based on previously computed list of deployments.
+	machineDeploymentChartValues, err := b.generateMachineDeploymentConfig(machineDeployments, classKind)
+	if err != nil {
+		return fmt.Errorf("Failed to generate the machine deployment config: '%s'", err.Error())
+	}
+
+	// Deploy generated machine deployments.
+	if err := b.ApplyChartSeed(filepath.Join(chartPathMachines), "machines", b.Shoot.SeedNamespace, machineDeploymentChartValues, nil); err != nil {
+		return fmt.Errorf("Failed to deploy the generated machine deployments: '%s'", err.Error())
+	}
+	return nil
+}
+
+// groupMachineDeploymentsByWorker groups the given <machineDeployments> by their worker groups. The groups are
+// returned in the order of the given <workerNames>.
+func groupMachineDeploymentsByWorker(machineDeployments []operation.MachineDeployment, workerNames []string) [][]operation.MachineDeployment {
+	var groups [][]operation.MachineDeployment
+
+	for _, workerName := range workerNames {
+		var group []operation.MachineDeployment
+		for _, deployment := range machineDeployments {
+			if deployment.WorkerName == workerName {
+				group = append(group, deployment)
+			}
+		}
+		if len(group) > 0 {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
 // generateMachineDeploymentConfig generates the configuration values for the machine deployment Helm chart. It
 // does that based on the provided list of to-be-deployed <machineDeployments>.
 func (b *HybridBotanist) generateMachineDeploymentConfig(machineDeployments []operation.MachineDeployment, classKind string) (map[string]interface{}, error) {
 	var values = []map[string]interface{}{}
 
 	for _, deployment := range machineDeployments {
+		// In case the Shoot is hibernated we scale all machine deployments down to zero. The desired worker sizes
+		// remain untouched in the Shoot specification so that they can be restored once the Shoot is woken up.
+		replicas := deployment.Replicas
+		if b.Shoot.Hibernated {
+			replicas = 0
+		}
+
 		values = append(values, map[string]interface{}{
 			"name":            deployment.Name,
-			"replicas":        deployment.Replicas,
+			"replicas":        replicas,
 			"minReadySeconds": 500,
 			"rollingUpdate": map[string]interface{}{
-				"maxSurge":       1,
-				"maxUnavailable": 1,
+				"maxSurge":       intOrPercentValue(deployment.MaxSurge, gardenv1beta1.DefaultWorkerMaxSurge),
+				"maxUnavailable": intOrPercentValue(deployment.MaxUnavailable, gardenv1beta1.DefaultWorkerMaxUnavailable),
 			},
 			"labels": map[string]interface{}{
 				"name": deployment.Name,
@@ -163,48 +226,168 @@ func (b *HybridBotanist) generateMachineDeploymentConfig(machineDeployments []op
 	}, nil
 }
 
+// intOrPercentValue returns the integer or the percentage of the given <value> in a form which can be rendered into
+// the machine deployment chart. It returns <defaultValue> if <value> is not set.
+func intOrPercentValue(value *intstr.IntOrString, defaultValue int) interface{} {
+	if value == nil {
+		return defaultValue
+	}
+	if value.Type == intstr.String {
+		return value.StrVal
+	}
+	return value.IntValue()
+}
+
+// reconcileNodeMetadata applies the labels, annotations and taints of the worker groups to all nodes of the Shoot
+// cluster. The nodes are assigned to their worker group by means of the worker group label.
+func (b *HybridBotanist) reconcileNodeMetadata() error {
+	workers := map[string]gardenv1beta1.Worker{}
+	for _, worker := range b.Shoot.GetWorkers() {
+		workers[worker.Name] = worker
+	}
+
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, item := range nodeList.Items {
+		worker, ok := workers[item.Labels[common.WorkerGroup]]
+		if !ok {
+			continue
+		}
+
+		// The kubelets update the status of their Node objects regularly, hence, the node is read again and the metadata
+		// is re-applied if the update conflicts.
+		node, updated := item.DeepCopy(), false
+		if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			if !common.ApplyWorkerNodeMetadata(node, worker) {
+				return nil
+			}
+			_, err := b.K8sShootClient.UpdateNode(node)
+			if err == nil {
+				updated = true
+				return nil
+			}
+			if apierrors.IsConflict(err) {
+				latest, getErr := b.K8sShootClient.GetNode(node.Name)
+				if getErr != nil {
+					return getErr
+				}
+				node = latest
+			}
+			return err
+		}); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if updated {
+			b.Logger.Infof("Updated the labels, annotations and taints of node %s", node.Name)
+		}
+	}
+
+	return nil
+}
+
 // waitUntilMachineDeploymentsAvailable waits for a maximum of 30 minutes until all the desired <machineDeployments>
-// were marked as healthy/available by the machine-controller-manager. It polls the status every 10 seconds.
+// were rolled out and marked as healthy/available by the machine-controller-manager. It polls the status every 5
+// seconds and reports the rollout progress of the affected worker groups in the last operation of the Shoot.
 func (b *HybridBotanist) waitUntilMachineDeploymentsAvailable(machineDeployments []operation.MachineDeployment) error {
-	var (
-		numReady   int64
-		numDesired int64
-	)
 	return wait.Poll(5*time.Second, 1800*time.Second, func() (bool, error) {
-		numReady, numDesired = 0, 0
-		var machineDeploymentList unstructured.Unstructured
+		var (
+			machineDeploymentList unstructured.Unstructured
+			rolledOut             = true
+			workerNames           = []string{}
+			numReady              = map[string]int64{}
+			numUpdated            = map[string]int64{}
+			numDesired            = map[string]int64{}
+		)
 
 		if err := b.K8sSeedClient.MachineV1alpha1("GET", "machinedeployments", b.Shoot.SeedNamespace).Do().Into(&machineDeploymentList); err != nil {
 			return false, err
 		}
 
+		existingDeployments := map[string]*unstructured.Unstructured{}
 		if err := machineDeploymentList.EachListItem(func(o runtime.Object) error {
-			for _, machineDeployment := range machineDeployments {
-				var (
-					obj                             = o.(*unstructured.Unstructured)
-					deploymentName                  = obj.GetName()
-					deploymentDesiredReplicas, _, _ = unstructured.NestedInt64(obj.UnstructuredContent(), "spec", "replicas")
-					deploymentReadyReplicas, _, _   = unstructured.NestedInt64(obj.UnstructuredContent(), "status", "readyReplicas")
-				)
-
-				if machineDeployment.Name == deploymentName {
-					numDesired += deploymentDesiredReplicas
-					numReady += deploymentReadyReplicas
-				}
-			}
+			obj := o.(*unstructured.Unstructured)
+			existingDeployments[obj.GetName()] = obj
 			return nil
 		}); err != nil {
 			return false, err
 		}
 
-		b.Logger.Infof("Waiting until all machines are healthy/ready (%d/%d OK)...", numReady, numDesired)
-		if numReady >= numDesired {
+		for _, machineDeployment := range machineDeployments {
+			if _, ok := numDesired[machineDeployment.WorkerName]; !ok {
+				workerNames = append(workerNames, machineDeployment.WorkerName)
+			}
+
+			obj, ok := existingDeployments[machineDeployment.Name]
+			if !ok {
+				numDesired[machineDeployment.WorkerName] += int64(machineDeployment.Replicas)
+				rolledOut = false
+				continue
+			}
+
+			var (
+				content                                 = obj.UnstructuredContent()
+				desiredReplicas, _, _                   = unstructured.NestedInt64(content, "spec", "replicas")
+				readyReplicas, _, _                     = unstructured.NestedInt64(content, "status", "readyReplicas")
+				updatedReplicas, _, _                   = unstructured.NestedInt64(content, "status", "updatedReplicas")
+				observedGeneration, observedGenFound, _ = unstructured.NestedInt64(content, "status", "observedGeneration")
+			)
+
+			numDesired[machineDeployment.WorkerName] += desiredReplicas
+			numReady[machineDeployment.WorkerName] += readyReplicas
+			numUpdated[machineDeployment.WorkerName] += updatedReplicas
+
+			if (observedGenFound && observedGeneration < obj.GetGeneration()) || readyReplicas < desiredReplicas || updatedReplicas < desiredReplicas {
+				rolledOut = false
+			}
+		}
+
+		progress := []string{}
+		for _, workerName := range workerNames {
+			progress = append(progress, fmt.Sprintf("%s: %d/%d updated, %d/%d ready", workerName, numUpdated[workerName], numDesired[workerName], numReady[workerName], numDesired[workerName]))
+		}
+		msg := fmt.Sprintf("Waiting until the machines of the worker groups are rolled out (%s)...", strings.Join(progress, "; "))
+
+		b.Logger.Info(msg)
+		if rolledOut {
 			return true, nil
 		}
+		b.ReportShootProgressDescription(msg)
 		return false, nil
 	})
 }
 
+// waitUntilMachinesDeleted waits for a maximum of 30 minutes until all machines have been deleted by the
+// machine-controller-manager (e.g., after all machine deployments have been scaled down). It polls the status
+// every 5 seconds.
+func (b *HybridBotanist) waitUntilMachinesDeleted() error {
+	return wait.Poll(5*time.Second, 1800*time.Second, func() (bool, error) {
+		var machineList unstructured.Unstructured
+		if err := b.K8sSeedClient.MachineV1alpha1("GET", "machines", b.Shoot.SeedNamespace).Do().Into(&machineList); err != nil {
+			return false, err
+		}
+
+		numberOfMachines := 0
+		if err := machineList.EachListItem(func(o runtime.Object) error {
+			numberOfMachines++
+			return nil
+		}); err != nil {
+			return false, err
+		}
+
+		if numberOfMachines > 0 {
+			b.Logger.Infof("Waiting until all machines have been deleted (%d left)...", numberOfMachines)
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
 // waitUntilMachineResourcesDeleted waits for a maximum of 30 minutes until all machine resoures have been properly
 // deleted by the machine-controller-manager. It polls the status every 10 seconds.
 func (b *HybridBotanist) waitUntilMachineResourcesDeleted(classKind string) error {
@@ -0,0 +1,144 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"io/ioutil"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/sirupsen/logrus"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fakeShootClient stores the nodes of the Shoot cluster. An update of a node conflicts as long as the number of
+// conflicts configured for the node is not exhausted, and it changes the status of the node in between (like a
+// kubelet). All other methods of the kubernetes.Client interface are not implemented.
+type fakeShootClient struct {
+	kubernetes.Client
+
+	nodes     map[string]*corev1.Node
+	conflicts map[string]int
+}
+
+func (c *fakeShootClient) GetNode(name string) (*corev1.Node, error) {
+	if node, ok := c.nodes[name]; ok {
+		return node.DeepCopy(), nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "nodes"}, name)
+}
+
+func (c *fakeShootClient) ListNodes(opts metav1.ListOptions) (*corev1.NodeList, error) {
+	list := &corev1.NodeList{}
+	for _, node := range c.nodes {
+		list.Items = append(list.Items, *node.DeepCopy())
+	}
+	return list, nil
+}
+
+func (c *fakeShootClient) UpdateNode(node *corev1.Node) (*corev1.Node, error) {
+	current, ok := c.nodes[node.Name]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "nodes"}, node.Name)
+	}
+	if c.conflicts[node.Name] > 0 {
+		c.conflicts[node.Name]--
+		current.ResourceVersion += "1"
+		current.Status.Phase = corev1.NodeRunning
+		return nil, apierrors.NewConflict(schema.GroupResource{Resource: "nodes"}, node.Name, nil)
+	}
+	if node.ResourceVersion != current.ResourceVersion {
+		return nil, apierrors.NewConflict(schema.GroupResource{Resource: "nodes"}, node.Name, nil)
+	}
+	c.nodes[node.Name] = node.DeepCopy()
+	return node, nil
+}
+
+var _ = Describe("machines", func() {
+	Describe("#reconcileNodeMetadata", func() {
+		var (
+			shootClient *fakeShootClient
+			botanist    *HybridBotanist
+
+			newNode = func(name, workerGroup string) *corev1.Node {
+				return &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            name,
+						ResourceVersion: "1",
+						Labels:          map[string]string{common.WorkerGroup: workerGroup},
+					},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			shootClient = &fakeShootClient{
+				nodes: map[string]*corev1.Node{
+					"node-1": newNode("node-1", "cpu-worker"),
+					"node-2": newNode("node-2", "other-worker"),
+				},
+				conflicts: map[string]int{},
+			}
+			botanist = &HybridBotanist{
+				Operation: &operation.Operation{
+					Logger:         logrus.NewEntry(&logrus.Logger{Out: ioutil.Discard}),
+					K8sShootClient: shootClient,
+					Shoot: &shoot.Shoot{
+						CloudProvider: gardenv1beta1.CloudProviderGCP,
+						Info: &gardenv1beta1.Shoot{
+							Spec: gardenv1beta1.ShootSpec{
+								Cloud: gardenv1beta1.Cloud{
+									GCP: &gardenv1beta1.GCPCloud{
+										Workers: []gardenv1beta1.GCPWorker{
+											{Worker: gardenv1beta1.Worker{Name: "cpu-worker", Labels: map[string]string{"foo": "bar"}}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should apply the metadata of the worker group to its nodes only", func() {
+			Expect(ExportReconcileNodeMetadata(botanist)).To(Succeed())
+
+			Expect(shootClient.nodes["node-1"].Labels).To(HaveKeyWithValue("foo", "bar"))
+			Expect(shootClient.nodes["node-2"].Labels).NotTo(HaveKey("foo"))
+		})
+
+		It("should re-read the node and keep its status if the update conflicts", func() {
+			shootClient.conflicts["node-1"] = 2
+
+			Expect(ExportReconcileNodeMetadata(botanist)).To(Succeed())
+
+			Expect(shootClient.conflicts["node-1"]).To(BeZero())
+			Expect(shootClient.nodes["node-1"].Labels).To(HaveKeyWithValue("foo", "bar"))
+			Expect(shootClient.nodes["node-1"].Status.Phase).To(Equal(corev1.NodeRunning))
+		})
+	})
+})
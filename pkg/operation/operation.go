@@ -17,6 +17,7 @@ package operation
 import (
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -27,10 +28,13 @@ import (
 	"github.com/gardener/gardener/pkg/operation/garden"
 	"github.com/gardener/gardener/pkg/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 )
 
 // New creates a new operation object.
@@ -138,13 +142,109 @@ func (o *Operation) GetSecretKeysOfRole(kind string) []string {
 // ReportShootProgress will update the last operation object in the Shoot manifest `status` section
 // by the current progress of the Flow execution.
 func (o *Operation) ReportShootProgress(progress int, currentFunctions string) {
-	o.Shoot.Info.Status.LastOperation.Description = "Currently executing " + currentFunctions
-	o.Shoot.Info.Status.LastOperation.Progress = progress
-	o.Shoot.Info.Status.LastOperation.LastUpdateTime = metav1.Now()
+	o.updateShootLastOperation(func(lastOperation *gardenv1beta1.LastOperation) bool {
+		lastOperation.Description = "Currently executing " + currentFunctions
+		lastOperation.Progress = progress
+		return true
+	})
+}
+
+// ReportShootProgressDescription will update the description of the last operation object in the Shoot manifest
+// `status` section without changing the progress, e.g. to report the progress of a long-running task.
+func (o *Operation) ReportShootProgressDescription(description string) {
+	o.updateShootLastOperation(func(lastOperation *gardenv1beta1.LastOperation) bool {
+		if lastOperation.Description == description {
+			return false
+		}
+		lastOperation.Description = description
+		return true
+	})
+}
+
+// updateShootLastOperation applies <mutate> to the last operation object of the Shoot and updates its status if
+// <mutate> returns true. The Flow reports its progress while tasks may report descriptions concurrently, hence,
+// the update is guarded by a lock. In case of a conflict, the latest version of the Shoot is read and the update
+// is retried.
+func (o *Operation) updateShootLastOperation(mutate func(*gardenv1beta1.LastOperation) bool) {
+	o.shootStatusLock.Lock()
+	defer o.shootStatusLock.Unlock()
+
+	var (
+		shoots = o.K8sGardenClient.GardenClientset().GardenV1beta1().Shoots(o.Shoot.Info.Namespace)
+		shoot  = o.Shoot.Info.DeepCopy()
+	)
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if shoot.Status.LastOperation == nil || !mutate(shoot.Status.LastOperation) {
+			return nil
+		}
+		shoot.Status.LastOperation.LastUpdateTime = metav1.Now()
+
+		newShoot, err := shoots.UpdateStatus(shoot)
+		if err == nil {
+			o.Shoot.Info = newShoot
+			return nil
+		}
+		if apierrors.IsConflict(err) {
+			if latest, getErr := shoots.Get(shoot.Name, metav1.GetOptions{}); getErr == nil {
+				shoot = latest
+			}
+		}
+		return err
+	}); err != nil {
+		o.Logger.Debugf("Could not report the progress of the last operation: %s", err.Error())
+	}
+}
+
+// GetFlowState reads the ConfigMap in the Shoot namespace of the Seed cluster which stores the state of an interrupted
+// Flow execution. It returns the list of tasks which have already succeeded in case the state belongs to the Flow with
+// name <flowName> and has been recorded for the current generation of the Shoot. Otherwise, an empty list is returned.
+func (o *Operation) GetFlowState(flowName string) []string {
+	configMap, err := o.K8sSeedClient.GetConfigMap(o.Shoot.SeedNamespace, common.FlowStateConfigMapName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			o.Logger.Errorf("Could not read the flow state: %s", err.Error())
+		}
+		return nil
+	}
 
-	if newShoot, err := o.K8sGardenClient.GardenClientset().GardenV1beta1().Shoots(o.Shoot.Info.Namespace).UpdateStatus(o.Shoot.Info); err == nil {
-		o.Shoot.Info = newShoot
+	if configMap.Data["flow"] != flowName || configMap.Data["generation"] != strconv.FormatInt(o.Shoot.Info.Generation, 10) || len(configMap.Data["succeededTasks"]) == 0 {
+		return nil
+	}
+	return strings.Split(configMap.Data["succeededTasks"], ",")
+}
+
+// ReportFlowState will store the list of succeeded resumable tasks of the given Flow <f> together with the current
+// generation of the Shoot in a ConfigMap in the Shoot namespace of the Seed cluster. It allows resuming the Flow in
+// case its execution gets interrupted. Additionally, the dependency graph of the Flow is stored in the JSON and DOT
+// format to allow investigating which tasks are currently blocking.
+func (o *Operation) ReportFlowState(f *flow.Flow) {
+	graphJSON, err := f.ExportJSON()
+	if err != nil {
+		o.Logger.Errorf("Could not export the flow graph: %s", err.Error())
+	}
+
+	data := map[string]string{
+		"flow":           f.Name,
+		"generation":     strconv.FormatInt(o.Shoot.Info.Generation, 10),
+		"succeededTasks": strings.Join(f.SucceededTasks, ","),
+		"graph.json":     string(graphJSON),
+		"graph.dot":      f.ExportDOT(),
+	}
+
+	if _, err := o.K8sSeedClient.CreateConfigMap(o.Shoot.SeedNamespace, common.FlowStateConfigMapName, data, true); err != nil && !apierrors.IsNotFound(err) {
+		o.Logger.Errorf("Could not record the flow state: %s", err.Error())
+	}
+}
+
+// DeleteFlowState deletes the ConfigMap in the Shoot namespace of the Seed cluster which stores the state of a Flow
+// execution. It must be called once the Flow has completed successfully.
+func (o *Operation) DeleteFlowState() error {
+	err := o.K8sSeedClient.DeleteConfigMap(o.Shoot.SeedNamespace, common.FlowStateConfigMapName)
+	if apierrors.IsNotFound(err) {
+		return nil
 	}
+	return err
 }
 
 // InjectImages injects images from the image vector into the provided <values> map.
@@ -16,6 +16,7 @@ package shoot
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/semver"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -50,12 +51,21 @@ func New(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.I
 	}
 
 	shootObj := &Shoot{
-		Info:                  shoot,
-		Secret:                secret,
-		CloudProfile:          cloudProfile,
-		SeedNamespace:         fmt.Sprintf("shoot-%s-%s", projectName, shoot.Name),
-		InternalClusterDomain: internalDomain,
-		Hibernated:            true,
+		Info:                      shoot,
+		Secret:                    secret,
+		CloudProfile:              cloudProfile,
+		SeedNamespace:             fmt.Sprintf("shoot-%s-%s", projectName, shoot.Name),
+		InternalClusterDomain:     internalDomain,
+		Hibernated:                helper.IsShootHibernated(shoot),
+		EtcdRestoreSnapshot:       shoot.Annotations[common.ShootRestoreEtcd],
+		CertificateRotation:       shoot.Annotations[common.ShootRotateCertificates],
+		EtcdEncryptionKeyRotation: shoot.Annotations[common.ShootRotateEtcdEncryptionKey] == "true",
+		CertificateSettings: CertificateSettings{
+			KeyAlgorithm: common.CertificateKeyAlgorithmRSA,
+			RSAKeySize:   2048,
+			CAValidity:   10 * 365 * 24 * time.Hour,
+			LeafValidity: 10 * 365 * 24 * time.Hour,
+		},
 	}
 
 	// Determine the external Shoot cluster domain, i.e. the domain which will be put into the Kubeconfig handed out
@@ -79,15 +89,6 @@ func New(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.I
 	}
 	shootObj.KubernetesMajorMinorVersion = fmt.Sprintf("%d.%d", v.Major(), v.Minor())
 
-	// Check whether the Shoot should be hibernated
-	workers := shootObj.GetWorkers()
-	for _, worker := range workers {
-		if worker.AutoScalerMax != 0 {
-			shootObj.Hibernated = false
-			break
-		}
-	}
-
 	return shootObj, nil
 }
 
@@ -143,6 +144,29 @@ func (s *Shoot) GetWorkerNames() []string {
 	return workerNames
 }
 
+// GetKubeletVersion returns the Kubernetes version of the kubelet running on the nodes of the given <worker> group.
+// It defaults to the Kubernetes version of the Shoot cluster.
+func (s *Shoot) GetKubeletVersion(worker gardenv1beta1.Worker) string {
+	if worker.KubeletVersion != nil {
+		return *worker.KubeletVersion
+	}
+	return s.Info.Spec.Kubernetes.Version
+}
+
+// GetKubeletMajorMinorVersion returns the Kubernetes version of the kubelet running on the nodes of the worker
+// group with the given <workerName> in the format <major>.<minor>.
+func (s *Shoot) GetKubeletMajorMinorVersion(workerName string) string {
+	for _, worker := range s.GetWorkers() {
+		if worker.Name != workerName || worker.KubeletVersion == nil {
+			continue
+		}
+		if v, err := semver.NewVersion(*worker.KubeletVersion); err == nil {
+			return fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+		}
+	}
+	return s.KubernetesMajorMinorVersion
+}
+
 // GetNodeCount returns the sum of all 'autoScalerMax' fields of all worker groups of the Shoot.
 func (s *Shoot) GetNodeCount() int {
 	nodeCount := 0
@@ -264,7 +288,7 @@ func (s *Shoot) MonocularEnabled() bool {
 
 // ComputeCloudConfigSecretName computes the name for a secret which contains the original cloud config for
 // the worker group with the given <workerName>. It is build by the cloud config secret prefix, the worker
-// name itself and a hash of the minor Kubernetes version of the Shoot cluster.
+// name itself and a hash of the minor Kubernetes version of the kubelet running on the nodes of the worker group.
 func (s *Shoot) ComputeCloudConfigSecretName(workerName string) string {
-	return fmt.Sprintf("%s-%s-%s", common.CloudConfigPrefix, workerName, utils.ComputeSHA256Hex([]byte(s.KubernetesMajorMinorVersion))[:5])
+	return fmt.Sprintf("%s-%s-%s", common.CloudConfigPrefix, workerName, utils.ComputeSHA256Hex([]byte(s.GetKubeletMajorMinorVersion(workerName)))[:5])
 }
@@ -15,19 +15,39 @@
 package shoot
 
 import (
+	"time"
+
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 )
 
 // Shoot is an object containing information about a Shoot cluster.
 type Shoot struct {
-	Info                        *gardenv1beta1.Shoot
-	Secret                      *corev1.Secret
-	CloudProfile                *gardenv1beta1.CloudProfile
-	CloudProvider               gardenv1beta1.CloudProvider
-	SeedNamespace               string
-	InternalClusterDomain       string
-	ExternalClusterDomain       *string
-	KubernetesMajorMinorVersion string
-	Hibernated                  bool
+	Info                              *gardenv1beta1.Shoot
+	Secret                            *corev1.Secret
+	CloudProfile                      *gardenv1beta1.CloudProfile
+	CloudProvider                     gardenv1beta1.CloudProvider
+	SeedNamespace                     string
+	InternalClusterDomain             string
+	ExternalClusterDomain             *string
+	KubernetesMajorMinorVersion       string
+	Hibernated                        bool
+	EtcdRestoreSnapshot               string
+	EtcdRestoreID                     string
+	EtcdRestored                      bool
+	CertificateSettings               CertificateSettings
+	CertificateRotation               string
+	CertificatesRotated               bool
+	Certificates                      []gardenv1beta1.Certificate
+	EtcdEncryptionKeyRotation         bool
+	EtcdEncryptionKeyRotated          bool
+	EtcdEncryptionReencryptionPending bool
+}
+
+// CertificateSettings contains the settings for the generation of the keys and certificates of a Shoot cluster.
+type CertificateSettings struct {
+	KeyAlgorithm string
+	RSAKeySize   int
+	CAValidity   time.Duration
+	LeafValidity time.Duration
 }
@@ -15,6 +15,8 @@
 package operation
 
 import (
+	"sync"
+
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
@@ -25,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Operation contains all data required to perform an operation on a Shoot cluster.
@@ -47,12 +50,17 @@ type Operation struct {
 	APIServerIngresses  []corev1.LoadBalancerIngress
 	APIServerAddress    string
 	SeedNamespaceObject *corev1.Namespace
+
+	shootStatusLock sync.Mutex
 }
 
-// MachineDeployment holds insformation about the name, class, replicas of a MachineDeployment
-// managed by the machine-controller-manager.
+// MachineDeployment holds insformation about the name, class, replicas and rolling update settings of a
+// MachineDeployment managed by the machine-controller-manager, and the name of the worker group it belongs to.
 type MachineDeployment struct {
-	Name      string
-	ClassName string
-	Replicas  int
+	Name           string
+	ClassName      string
+	Replicas       int
+	WorkerName     string
+	MaxSurge       *intstr.IntOrString
+	MaxUnavailable *intstr.IntOrString
 }
@@ -40,6 +40,7 @@ func (quotaStrategy) NamespaceScoped() bool {
 
 func (quotaStrategy) PrepareForCreate(ctx genericapirequest.Context, obj runtime.Object) {
 	quota := obj.(*garden.Quota)
+	quota.Status = garden.QuotaStatus{}
 
 	finalizers := sets.NewString(quota.Finalizers...)
 	if !finalizers.Has(gardenv1beta1.GardenerName) {
@@ -61,8 +62,9 @@ func (quotaStrategy) AllowCreateOnUpdate() bool {
 }
 
 func (quotaStrategy) PrepareForUpdate(ctx genericapirequest.Context, newObj, oldObj runtime.Object) {
-	_ = oldObj.(*garden.Quota)
-	_ = newObj.(*garden.Quota)
+	newQuota := newObj.(*garden.Quota)
+	oldQuota := oldObj.(*garden.Quota)
+	newQuota.Status = oldQuota.Status
 }
 
 func (quotaStrategy) ValidateUpdate(ctx genericapirequest.Context, newObj, oldObj runtime.Object) field.ErrorList {
@@ -73,3 +75,20 @@ func (quotaStrategy) ValidateUpdate(ctx genericapirequest.Context, newObj, oldOb
 func (quotaStrategy) AllowUnconditionalUpdate() bool {
 	return true
 }
+
+type quotaStatusStrategy struct {
+	quotaStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of Quotas.
+var StatusStrategy = quotaStatusStrategy{Strategy}
+
+func (quotaStatusStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+	newQuota := obj.(*garden.Quota)
+	oldQuota := old.(*garden.Quota)
+	newQuota.Spec = oldQuota.Spec
+}
+
+func (quotaStatusStrategy) ValidateUpdate(ctx genericapirequest.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateQuotaStatusUpdate(obj.(*garden.Quota), old.(*garden.Quota))
+}
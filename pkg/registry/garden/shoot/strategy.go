@@ -79,6 +79,21 @@ func mustIncreaseGeneration(oldShoot, newShoot *garden.Shoot) bool {
 		return true
 	}
 
+	// A restoration of the etcd from a backup snapshot was requested.
+	if val, ok := newShoot.Annotations[common.ShootRestoreEtcd]; ok && val != oldShoot.Annotations[common.ShootRestoreEtcd] {
+		return true
+	}
+
+	// A rotation of the certificates was requested.
+	if val, ok := newShoot.Annotations[common.ShootRotateCertificates]; ok && val != oldShoot.Annotations[common.ShootRotateCertificates] {
+		return true
+	}
+
+	// A rotation of the etcd encryption key was requested.
+	if val, ok := newShoot.Annotations[common.ShootRotateEtcdEncryptionKey]; ok && val != oldShoot.Annotations[common.ShootRotateEtcdEncryptionKey] {
+		return true
+	}
+
 	// The shoot state was failed but the retry annotation was set.
 	lastOperation := newShoot.Status.LastOperation
 	if lastOperation != nil && lastOperation.State == garden.ShootLastOperationStateFailed {
@@ -63,8 +63,14 @@ func (m metrics) initShootMetrics() {
 		Help: "Node count of a Shoot cluster",
 	}, []string{"name", "project"})
 
+	metricShootCertificateExpiry := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "garden_shoot_certificate_expiry_seconds",
+		Help: "Seconds until the certificate of a Shoot cluster expires",
+	}, []string{"name", "project", "secret"})
+
 	prometheus.Register(metricShootState)
 	prometheus.Register(metricShootNodeCount)
+	prometheus.Register(metricShootCertificateExpiry)
 
 	m.collect(func() {
 		var state float64
@@ -113,6 +119,14 @@ func (m metrics) initShootMetrics() {
 				"name":    shoot.Name,
 				"project": shoot.Namespace,
 			}).Set(float64(nodeCount))
+
+			for _, certificate := range shoot.Status.Certificates {
+				metricShootCertificateExpiry.With(prometheus.Labels{
+					"name":    shoot.Name,
+					"project": shoot.Namespace,
+					"secret":  certificate.Name,
+				}).Set(time.Until(certificate.NotAfter.Time).Seconds())
+			}
 		}
 	})
 }
@@ -0,0 +1,203 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed Cron spec in the standard five field format (minute, hour, day of month, month,
+// day of week).
+type CronSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	dayOfMonthRestricted, dayOfWeekRestricted  bool
+}
+
+type cronField struct {
+	name     string
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	cronMinute     = cronField{name: "minute", min: 0, max: 59}
+	cronHour       = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth      = cronField{name: "month", min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	cronDayOfWeek = cronField{name: "day of week", min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// ParseCronSchedule parses the given Cron spec. Each field may contain a wildcard (*), single values, ranges
+// (a-b), steps (*/n or a-b/n) and comma-separated lists thereof. Months and days of week may also be given by
+// their three letter English names (e.g. JAN, MON). An error is returned if the spec is invalid.
+func ParseCronSchedule(spec string) (*CronSchedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected exactly 5 fields in cron spec %q, found %d", spec, len(fields))
+	}
+
+	var (
+		schedule = &CronSchedule{}
+		err      error
+	)
+
+	if schedule.minute, err = parseCronField(fields[0], cronMinute); err != nil {
+		return nil, err
+	}
+	if schedule.hour, err = parseCronField(fields[1], cronHour); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfMonth, err = parseCronField(fields[2], cronDayOfMonth); err != nil {
+		return nil, err
+	}
+	if schedule.month, err = parseCronField(fields[3], cronMonth); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfWeek, err = parseCronField(fields[4], cronDayOfWeek); err != nil {
+		return nil, err
+	}
+
+	// Sunday may be specified as 0 or 7.
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+	schedule.dayOfMonthRestricted = fields[2] != "*"
+	schedule.dayOfWeekRestricted = fields[4] != "*"
+
+	return schedule, nil
+}
+
+// Next returns the first activation time of the schedule which is strictly after <t>. The activation time is
+// computed in the location of <t>. A zero time is returned if there is no activation time within the next five
+// years (e.g., for the 30th of February).
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if !isCronBitSet(s.month, uint(t.Month())) {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, 0)
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1)
+			continue
+		}
+		if !isCronBitSet(s.hour, uint(t.Hour())) {
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			if !next.After(t) {
+				// Daylight saving time transitions might lead to ambiguous wall clock times.
+				next = t.Add(time.Hour)
+			}
+			t = next
+			continue
+		}
+		if !isCronBitSet(s.minute, uint(t.Minute())) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// matchesDay checks whether the day of <t> matches the schedule. As usual for Cron, if both the day of month and
+// the day of week are restricted then it is sufficient if one of them matches.
+func (s *CronSchedule) matchesDay(t time.Time) bool {
+	var (
+		dayOfMonth = isCronBitSet(s.dayOfMonth, uint(t.Day()))
+		dayOfWeek  = isCronBitSet(s.dayOfWeek, uint(t.Weekday()))
+	)
+
+	if s.dayOfMonthRestricted && s.dayOfWeekRestricted {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+func parseCronField(value string, field cronField) (uint64, error) {
+	var bits uint64
+
+	for _, expr := range strings.Split(value, ",") {
+		var (
+			rangeExpr = expr
+			step      = uint(1)
+			start     = field.min
+			end       = field.max
+		)
+
+		if i := strings.Index(expr, "/"); i >= 0 {
+			s, err := strconv.ParseUint(expr[i+1:], 10, 0)
+			if err != nil || s == 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", expr[i+1:], field.name)
+			}
+			step, rangeExpr = uint(s), expr[:i]
+		}
+
+		if rangeExpr != "*" {
+			bounds := strings.SplitN(rangeExpr, "-", 2)
+
+			var err error
+			if start, err = parseCronValue(bounds[0], field); err != nil {
+				return 0, err
+			}
+			end = start
+			if len(bounds) == 2 {
+				if end, err = parseCronValue(bounds[1], field); err != nil {
+					return 0, err
+				}
+			} else if step != 1 {
+				end = field.max
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range %q in %s field", rangeExpr, field.name)
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << i
+		}
+	}
+
+	return bits, nil
+}
+
+func parseCronValue(value string, field cronField) (uint, error) {
+	if v, ok := field.names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.ParseUint(value, 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, field.name)
+	}
+	if uint(v) < field.min || uint(v) > field.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d] in %s field", v, field.min, field.max, field.name)
+	}
+	return uint(v), nil
+}
+
+func isCronBitSet(bits uint64, i uint) bool {
+	return bits&(1<<i) != 0
+}
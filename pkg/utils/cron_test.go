@@ -0,0 +1,90 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener/pkg/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("cron", func() {
+	Describe("#ParseCronSchedule", func() {
+		It("should parse valid cron specs", func() {
+			for _, spec := range []string{"* * * * *", "0 18 * * 1-5", "*/15 0,12 1 JAN-jun MON", "30 8 * * 7", "0 0 1-31/2 * *"} {
+				_, err := ParseCronSchedule(spec)
+				Expect(err).NotTo(HaveOccurred(), spec)
+			}
+		})
+
+		It("should return an error for invalid cron specs", func() {
+			for _, spec := range []string{"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "a * * * *"} {
+				_, err := ParseCronSchedule(spec)
+				Expect(err).To(HaveOccurred(), spec)
+			}
+		})
+	})
+
+	Describe("#Next", func() {
+		var now = time.Date(2018, 5, 2, 10, 30, 15, 0, time.UTC) // Wednesday
+
+		It("should return the next minute for a wildcard spec", func() {
+			schedule, _ := ParseCronSchedule("* * * * *")
+
+			Expect(schedule.Next(now)).To(Equal(time.Date(2018, 5, 2, 10, 31, 0, 0, time.UTC)))
+		})
+
+		It("should return the next activation on the same day", func() {
+			schedule, _ := ParseCronSchedule("0 18 * * 1-5")
+
+			Expect(schedule.Next(now)).To(Equal(time.Date(2018, 5, 2, 18, 0, 0, 0, time.UTC)))
+		})
+
+		It("should skip days which do not match the day of week", func() {
+			schedule, _ := ParseCronSchedule("0 8 * * MON")
+
+			Expect(schedule.Next(now)).To(Equal(time.Date(2018, 5, 7, 8, 0, 0, 0, time.UTC)))
+		})
+
+		It("should match if either day of month or day of week match", func() {
+			schedule, _ := ParseCronSchedule("0 0 15 * FRI")
+
+			Expect(schedule.Next(now)).To(Equal(time.Date(2018, 5, 4, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should roll over to the next year", func() {
+			schedule, _ := ParseCronSchedule("0 0 1 JAN *")
+
+			Expect(schedule.Next(now)).To(Equal(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should evaluate the schedule in the location of the given time", func() {
+			location, err := time.LoadLocation("Europe/Berlin")
+			Expect(err).NotTo(HaveOccurred())
+			schedule, _ := ParseCronSchedule("0 8 * * *")
+
+			Expect(schedule.Next(now.In(location))).To(Equal(time.Date(2018, 5, 3, 6, 0, 0, 0, time.UTC).In(location)))
+		})
+
+		It("should return the zero time if there is no activation", func() {
+			schedule, _ := ParseCronSchedule("0 0 30 2 *")
+
+			Expect(schedule.Next(now).IsZero()).To(BeTrue())
+		})
+	})
+})
@@ -15,6 +15,8 @@
 package utils
 
 import (
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -23,6 +25,7 @@ import (
 	"encoding/hex"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"sort"
 	"strconv"
 )
@@ -60,6 +63,44 @@ func DecodePrivateKey(bytes []byte) (*rsa.PrivateKey, error) {
 	return x509.ParsePKCS1PrivateKey(block.Bytes)
 }
 
+// EncodeSigner takes a RSA or ECDSA private key object, encodes it to the PEM format, and returns it as
+// a byte slice. In case the type of the key is not supported, it returns an error.
+func EncodeSigner(key crypto.Signer) ([]byte, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return EncodePrivateKey(k), nil
+	case *ecdsa.PrivateKey:
+		bytes, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{
+			Type:  "EC PRIVATE KEY",
+			Bytes: bytes,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// DecodeSigner takes a byte slice, decodes it from the PEM format, converts it to either a rsa.PrivateKey
+// or an ecdsa.PrivateKey object (depending on the PEM block type), and returns it. In case an error occurs,
+// it returns the error.
+func DecodeSigner(bytes []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(bytes)
+	if block == nil {
+		return nil, errors.New("could not decode the PEM-encoded private key")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %s", block.Type)
+	}
+}
+
 // EncodeCertificate takes a certificate as a byte slice, encodes it to the PEM format, and returns
 // it as byte slice.
 func EncodeCertificate(certificate []byte) []byte {
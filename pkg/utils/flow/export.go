@@ -0,0 +1,118 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Graph is the exported representation of a flow including the state of all its tasks.
+type Graph struct {
+	Name  string      `json:"name"`
+	Tasks []GraphTask `json:"tasks"`
+}
+
+// GraphTask is the exported representation of a task of a flow.
+type GraphTask struct {
+	Name       string     `json:"name"`
+	Status     TaskStatus `json:"status"`
+	DependsOn  []string   `json:"dependsOn,omitempty"`
+	StartTime  *time.Time `json:"startTime,omitempty"`
+	EndTime    *time.Time `json:"endTime,omitempty"`
+	RetryCount int        `json:"retryCount"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// Graph returns a snapshot of the dependency graph of the flow including the current status, the start and
+// end time and the retry count of each task.
+func (f *Flow) Graph() *Graph {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	graph := &Graph{
+		Name:  f.Name,
+		Tasks: []GraphTask{},
+	}
+
+	for _, task := range f.Tasks {
+		graphTask := GraphTask{
+			Name:      task.Name,
+			Status:    task.Status,
+			StartTime: task.StartTime,
+			EndTime:   task.EndTime,
+		}
+		if task.Attempts > 1 {
+			graphTask.RetryCount = task.Attempts - 1
+		}
+		if task.Error != nil {
+			graphTask.Error = task.Error.Description
+		}
+		for _, dependency := range task.Dependencies {
+			graphTask.DependsOn = append(graphTask.DependsOn, dependency.Name)
+		}
+		graph.Tasks = append(graph.Tasks, graphTask)
+	}
+
+	return graph
+}
+
+// ExportJSON returns the JSON representation of the dependency graph of the flow.
+func (f *Flow) ExportJSON() ([]byte, error) {
+	return json.Marshal(f.Graph())
+}
+
+// ExportDOT returns the representation of the dependency graph of the flow in the DOT language. Tasks are
+// coloured according to their status, and their labels contain the status and the retry count.
+func (f *Flow) ExportDOT() string {
+	var (
+		graph = f.Graph()
+		buf   bytes.Buffer
+	)
+
+	fmt.Fprintf(&buf, "digraph %q {\n", graph.Name)
+	for _, task := range graph.Tasks {
+		label := fmt.Sprintf("%s\n%s", task.Name, task.Status)
+		if task.RetryCount > 0 {
+			label += fmt.Sprintf(" (%d retries)", task.RetryCount)
+		}
+		fmt.Fprintf(&buf, "  %q [label=%q, color=%q];\n", task.Name, label, dotColor(task.Status))
+	}
+	for _, task := range graph.Tasks {
+		for _, dependency := range task.DependsOn {
+			fmt.Fprintf(&buf, "  %q -> %q;\n", dependency, task.Name)
+		}
+	}
+	buf.WriteString("}\n")
+
+	return buf.String()
+}
+
+func dotColor(status TaskStatus) string {
+	switch status {
+	case TaskStatusRunning:
+		return "blue"
+	case TaskStatusSucceeded:
+		return "green"
+	case TaskStatusFailed:
+		return "red"
+	case TaskStatusSkipped:
+		return "grey"
+	default:
+		return "black"
+	}
+}
@@ -15,6 +15,7 @@
 package flow
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -28,18 +29,33 @@ import (
 func New(name string) *Flow {
 	return &Flow{
 		Name:           name,
+		ErrorPolicy:    ErrorPolicyContinueIndependent,
 		DoneCh:         make(chan *Task),
+		Tasks:          TaskList{},
 		ActiveTasks:    TaskList{},
 		RootTasks:      TaskList{},
 		ErrornousTasks: TaskList{},
+		SucceededTasks: []string{},
 	}
 }
 
-// AddTask takes a <function> and a <retryDuration> and returns a pointer to a Task object.
-func (f *Flow) AddTask(function func() error, retryDuration time.Duration, dependsOn ...*Task) *Task {
+// AddTask takes a <name>, a <function> and a <retryDuration> and returns a pointer to a Task object.
+// The <name> must be unique within the flow as it is used to identify the task in logs, the progress
+// description and the recorded state.
+func (f *Flow) AddTask(name string, function func() error, retryDuration time.Duration, dependsOn ...*Task) *Task {
+	return f.AddTaskWithContext(name, func(_ context.Context) error { return function() }, retryDuration, dependsOn...)
+}
+
+// AddTaskWithContext takes a <name>, a <function> and a <retryDuration> and returns a pointer to a Task object.
+// The <function> receives the context of the flow execution (limited by the timeout of the task, if any) and
+// should return as soon as the context is done.
+func (f *Flow) AddTaskWithContext(name string, function func(context.Context) error, retryDuration time.Duration, dependsOn ...*Task) *Task {
 	task := &Task{
+		Name:                        name,
 		Function:                    function,
 		RetryDuration:               retryDuration,
+		Status:                      TaskStatusPending,
+		Dependencies:                dependsOn,
 		TriggerTasks:                TaskList{},
 		NumberOfPendingDependencies: len(dependsOn),
 	}
@@ -49,14 +65,22 @@ func (f *Flow) AddTask(function func() error, retryDuration time.Duration, depen
 	for _, t := range dependsOn {
 		t.TriggerTasks = append(t.TriggerTasks, task)
 	}
+	f.Tasks = append(f.Tasks, task)
 	f.NumberOfExecutableTasks++
 	return task
 }
 
-// AddTaskConditional takes a <function> and a <retryDuration> and returns a pointer to a Task object.
+// AddTaskConditional takes a <name>, a <function> and a <retryDuration> and returns a pointer to a Task object.
 // In case the <condition> is false, the task will be marked as "skipped".
-func (f *Flow) AddTaskConditional(function func() error, retryDuration time.Duration, condition bool, dependsOn ...*Task) *Task {
-	task := f.AddTask(function, retryDuration, dependsOn...)
+func (f *Flow) AddTaskConditional(name string, function func() error, retryDuration time.Duration, condition bool, dependsOn ...*Task) *Task {
+	return f.AddTaskConditionalWithContext(name, func(_ context.Context) error { return function() }, retryDuration, condition, dependsOn...)
+}
+
+// AddTaskConditionalWithContext takes a <name>, a <function> and a <retryDuration> and returns a pointer to a
+// Task object. The <function> receives the context of the flow execution. In case the <condition> is false, the
+// task will be marked as "skipped".
+func (f *Flow) AddTaskConditionalWithContext(name string, function func(context.Context) error, retryDuration time.Duration, condition bool, dependsOn ...*Task) *Task {
+	task := f.AddTaskWithContext(name, function, retryDuration, dependsOn...)
 	if !condition {
 		f.NumberOfExecutableTasks--
 	}
@@ -64,10 +88,17 @@ func (f *Flow) AddTaskConditional(function func() error, retryDuration time.Dura
 	return task
 }
 
-// AddSyncPoint takes a list of tasks and returns a dummy task which can be used by others
+// AddSyncPoint takes a <name> and a list of tasks and returns a dummy task which can be used by others
 // as dependency. With that, a long list of dependencies must only defined once.
-func (f *Flow) AddSyncPoint(dependsOn ...*Task) *Task {
-	return f.AddTaskConditional(func() error { return nil }, 0, false, dependsOn...)
+func (f *Flow) AddSyncPoint(name string, dependsOn ...*Task) *Task {
+	return f.AddTaskConditional(name, func() error { return nil }, 0, false, dependsOn...)
+}
+
+// SetErrorPolicy will take an error <policy> and store it on the Flow object. The policy defines whether further
+// tasks are started once a task has failed. By default, the ErrorPolicyContinueIndependent policy is used.
+func (f *Flow) SetErrorPolicy(policy ErrorPolicy) *Flow {
+	f.ErrorPolicy = policy
+	return f
 }
 
 // SetProgressReporter will take a function <reporter> and store it on the Flow object. The
@@ -78,6 +109,21 @@ func (f *Flow) SetProgressReporter(reporter func(int, string)) *Flow {
 	return f
 }
 
+// SetStateRecorder will take a function <recorder> and store it on the Flow object. The function will be called
+// whenever the state of a task changes. It will receive the Flow itself as argument which can be used to retrieve
+// the list of succeeded resumable tasks (SucceededTasks) or to export the current state of the graph.
+func (f *Flow) SetStateRecorder(recorder func(*Flow)) *Flow {
+	f.StateRecorderFunc = recorder
+	return f
+}
+
+// Resume will take a list of task names <succeededTasks> which have been recorded by a previous (interrupted)
+// execution of the Flow. Resumable tasks whose names are contained in the list will not be executed again.
+func (f *Flow) Resume(succeededTasks []string) *Flow {
+	f.ResumedTasks = succeededTasks
+	return f
+}
+
 // SetLogger will take a <logger> and store it on the Flow object. The logger will be used at the begin of each
 // function invocation, and in case of errors.
 func (f *Flow) SetLogger(logger *logrus.Entry) *Flow {
@@ -85,15 +131,17 @@ func (f *Flow) SetLogger(logger *logrus.Entry) *Flow {
 	return f
 }
 
-// Execute will execute all tasks in the flow.
-func (f *Flow) Execute() *gardenv1beta1.LastError {
+// Execute will execute all tasks in the flow. The context <ctx> is propagated to all tasks. Once it is done,
+// no further tasks will be started and the currently running tasks are aborted.
+func (f *Flow) Execute(ctx context.Context) *gardenv1beta1.LastError {
 	f.infof("Starting flow %s", f.Name)
 	for _, task := range f.RootTasks {
-		f.startTask(task)
+		f.startTask(ctx, task)
 	}
-	f.handleFlow()
+	f.recordState()
+	f.handleFlow(ctx)
 
-	err := f.aggregateErrors()
+	err := f.aggregateErrors(ctx)
 	if err != nil {
 		return err
 	}
@@ -102,7 +150,7 @@ func (f *Flow) Execute() *gardenv1beta1.LastError {
 	return nil
 }
 
-func (f *Flow) handleFlow() {
+func (f *Flow) handleFlow(ctx context.Context) {
 	for len(f.ActiveTasks) > 0 {
 		t := <-f.DoneCh
 		if !t.Skip {
@@ -112,9 +160,13 @@ func (f *Flow) handleFlow() {
 		if t.Error != nil {
 			f.errorf("An error occurred while executing %s: %s", t, t.Error.Description)
 			f.ErrornousTasks = append(f.ErrornousTasks, t)
-		} else {
-			f.triggerDependencies(t)
+		} else if t.Resumable && !t.Skip {
+			f.SucceededTasks = append(f.SucceededTasks, t.Name)
 		}
+		if f.mayTriggerDependencies(ctx, t) {
+			f.triggerDependencies(ctx, t)
+		}
+		f.recordState()
 		if f.ProgressReporterFunc != nil {
 			f.ProgressReporterFunc(100*f.NumberOfCompletedTasks/(f.NumberOfExecutableTasks), f.ActiveTasks.String())
 		}
@@ -122,27 +174,125 @@ func (f *Flow) handleFlow() {
 	close(f.DoneCh)
 }
 
-func (f *Flow) startTask(task *Task) {
+func (f *Flow) startTask(ctx context.Context, task *Task) {
 	f.ActiveTasks = append(f.ActiveTasks, task)
+	f.setTaskStatus(task, TaskStatusRunning, nil)
 	go func() {
-		if !task.Skip {
+		switch {
+		case f.isResumed(task):
+			f.infof("Skipped %s (already succeeded in a previous execution)", task)
+			f.setTaskStatus(task, TaskStatusSucceeded, nil)
+		case task.Skip:
+			f.infof("Skipped %s", task)
+			f.setTaskStatus(task, TaskStatusSkipped, nil)
+		default:
 			f.infof("Executing %s", task)
-			err := utils.Retry(f.Logger, task.RetryDuration, utils.RetryFunc(f.Logger, task.Function))
-			if err != nil {
-				task.Error = utilerrors.New(err)
+			if err := f.executeTask(ctx, task); err != nil {
+				f.setTaskStatus(task, TaskStatusFailed, err)
+			} else {
+				f.setTaskStatus(task, TaskStatusSucceeded, nil)
 			}
-		} else {
-			f.infof("Skipped %s", task)
 		}
 		f.DoneCh <- task
 	}()
 }
 
-func (f *Flow) triggerDependencies(task *Task) {
+// executeTask executes the function of the given <task> until it succeeds or its retry duration has passed.
+// In case the task has a timeout, the context passed to the function is done once the timeout is exceeded and
+// no further retries are started. A running function is never abandoned, i.e. functions which do not respect
+// the context are only aborted after they have returned.
+func (f *Flow) executeTask(ctx context.Context, task *Task) error {
+	taskCtx := ctx
+	if task.Timeout > 0 {
+		var cancel context.CancelFunc
+		taskCtx, cancel = context.WithTimeout(ctx, task.Timeout)
+		defer cancel()
+	}
+
+	err := utils.RetryWithContext(taskCtx, f.Logger, task.RetryDuration, func() (bool, error) {
+		f.incrementTaskAttempts(task)
+		if err := runWithContext(taskCtx, task.Function); err != nil {
+			f.infof("Execution of %s did not succeed... (%s)", task, err.Error())
+			return false, err
+		}
+		return true, nil
+	})
+	if err != nil && ctx.Err() == nil && taskCtx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("timeout of %s exceeded (%s)", task.Timeout, err.Error())
+	}
+	return err
+}
+
+// runWithContext executes the <function> with the context <ctx> and waits until it returns, even if the context
+// is done in the meantime. Otherwise, functions which do not respect the context would keep running (and modifying
+// state) after the flow has already returned. In case the function fails after the context is done, the error of
+// the context is returned.
+func runWithContext(ctx context.Context, function func(context.Context) error) error {
+	err := function(ctx)
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func (f *Flow) isResumed(task *Task) bool {
+	return task.Resumable && !task.Skip && utils.ValueExists(task.Name, f.ResumedTasks)
+}
+
+func (f *Flow) recordState() {
+	if f.StateRecorderFunc != nil {
+		f.StateRecorderFunc(f)
+	}
+}
+
+// setTaskStatus sets the <status> of the given <task>. In case <err> is not nil, it is stored as error of the task.
+// The task must only be modified while holding the lock as the state recorder exports it concurrently.
+func (f *Flow) setTaskStatus(task *Task, status TaskStatus, err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err != nil {
+		task.Error = utilerrors.New(err)
+	}
+
+	now := time.Now().UTC()
+	switch status {
+	case TaskStatusRunning:
+		task.StartTime = &now
+	case TaskStatusSucceeded, TaskStatusFailed, TaskStatusSkipped:
+		task.EndTime = &now
+	}
+	task.Status = status
+}
+
+func (f *Flow) incrementTaskAttempts(task *Task) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	task.Attempts++
+}
+
+// mayTriggerDependencies checks whether the dependent tasks of the given completed <task> may be started, based
+// on the context <ctx>, the result of the <task> and the error policy of the flow.
+func (f *Flow) mayTriggerDependencies(ctx context.Context, task *Task) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	switch f.ErrorPolicy {
+	case ErrorPolicyFailFast:
+		return len(f.ErrornousTasks) == 0
+	case ErrorPolicyBestEffort:
+		return true
+	default:
+		return task.Error == nil
+	}
+}
+
+func (f *Flow) triggerDependencies(ctx context.Context, task *Task) {
 	for _, t := range task.TriggerTasks {
 		t.NumberOfPendingDependencies--
 		if t.NumberOfPendingDependencies == 0 {
-			f.startTask(t)
+			f.startTask(ctx, t)
 		}
 	}
 }
@@ -156,8 +306,9 @@ func (f *Flow) removeFromActiveTasks(task *Task) {
 	}
 }
 
-func (f *Flow) aggregateErrors() *gardenv1beta1.LastError {
-	if len(f.ErrornousTasks) == 0 {
+func (f *Flow) aggregateErrors(ctx context.Context) *gardenv1beta1.LastError {
+	cancelled := ctx.Err() != nil && f.hasPendingTasks()
+	if len(f.ErrornousTasks) == 0 && !cancelled {
 		return nil
 	}
 
@@ -169,6 +320,12 @@ func (f *Flow) aggregateErrors() *gardenv1beta1.LastError {
 		sep = ""
 	)
 
+	if cancelled {
+		f.errorf("Flow %s has been cancelled: %s", f.Name, ctx.Err().Error())
+		e += fmt.Sprintf("flow has been cancelled before all tasks were executed (%s)", ctx.Err().Error())
+		sep = ", "
+	}
+
 	for _, t := range f.ErrornousTasks {
 		if t.Error.Code != nil {
 			lastError.Codes = append(lastError.Codes, *(t.Error.Code))
@@ -182,6 +339,15 @@ func (f *Flow) aggregateErrors() *gardenv1beta1.LastError {
 	return lastError
 }
 
+func (f *Flow) hasPendingTasks() bool {
+	for _, t := range f.Tasks {
+		if t.Status == TaskStatusPending {
+			return true
+		}
+	}
+	return false
+}
+
 func (f *Flow) infof(format string, args ...interface{}) {
 	if f.Logger != nil {
 		f.Logger.Infof(format, args...)
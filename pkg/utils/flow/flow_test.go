@@ -0,0 +1,240 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	. "github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/sirupsen/logrus"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("flow", func() {
+	var (
+		logger   *logrus.Entry
+		lock     sync.Mutex
+		executed []string
+		record   = func(name string, err error) func() error {
+			return func() error {
+				lock.Lock()
+				defer lock.Unlock()
+				executed = append(executed, name)
+				return err
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		logger = logrus.NewEntry(&logrus.Logger{Out: ioutil.Discard})
+		executed = nil
+	})
+
+	Describe("#Execute", func() {
+		It("should execute all tasks in the order of their dependencies", func() {
+			var (
+				f  = New("test").SetLogger(logger)
+				t1 = f.AddTask("first", record("first", nil), 0)
+				_  = f.AddTask("second", record("second", nil), 0, t1)
+			)
+
+			Expect(f.Execute(context.TODO())).To(BeNil())
+			Expect(executed).To(Equal([]string{"first", "second"}))
+		})
+
+		It("should not execute dependents of failed tasks", func() {
+			var (
+				f  = New("test").SetLogger(logger)
+				t1 = f.AddTask("first", record("first", errors.New("fail")), 0)
+				_  = f.AddTask("second", record("second", nil), 0, t1)
+			)
+
+			lastError := f.Execute(context.TODO())
+
+			Expect(lastError).NotTo(BeNil())
+			Expect(executed).To(Equal([]string{"first"}))
+		})
+	})
+
+	Describe("#Execute with context", func() {
+		It("should not start remaining tasks once the context is cancelled", func() {
+			var (
+				ctx, cancel = context.WithCancel(context.Background())
+
+				f  = New("test").SetLogger(logger)
+				t1 = f.AddTask("first", func() error {
+					cancel()
+					return record("first", nil)()
+				}, 0)
+				_ = f.AddTask("second", record("second", nil), 0, t1)
+			)
+
+			lastError := f.Execute(ctx)
+
+			Expect(lastError).NotTo(BeNil())
+			Expect(lastError.Description).To(ContainSubstring("cancelled"))
+			Expect(executed).To(Equal([]string{"first"}))
+		})
+
+		It("should abort tasks which exceed their timeout", func() {
+			var (
+				f = New("test").SetLogger(logger)
+				_ = f.AddTaskWithContext("first", func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}, 0).SetTimeout(10 * time.Millisecond)
+			)
+
+			lastError := f.Execute(context.TODO())
+
+			Expect(lastError).NotTo(BeNil())
+			Expect(lastError.Description).To(ContainSubstring("timeout of 10ms exceeded"))
+		})
+
+		It("should wait for running tasks which do not respect the context", func() {
+			var (
+				f = New("test").SetLogger(logger)
+				_ = f.AddTask("first", func() error {
+					time.Sleep(50 * time.Millisecond)
+					return record("first", errors.New("fail"))()
+				}, 0).SetTimeout(10 * time.Millisecond)
+			)
+
+			lastError := f.Execute(context.TODO())
+
+			Expect(lastError).NotTo(BeNil())
+			Expect(lastError.Description).To(ContainSubstring("timeout of 10ms exceeded"))
+			Expect(executed).To(Equal([]string{"first"}))
+		})
+	})
+
+	Describe("#SetErrorPolicy", func() {
+		var (
+			f      *Flow
+			failed = func() error { return errors.New("fail") }
+			sleep  = func() error {
+				time.Sleep(50 * time.Millisecond)
+				return record("slow", nil)()
+			}
+		)
+
+		BeforeEach(func() {
+			f = New("test").SetLogger(logger)
+			var (
+				t1 = f.AddTask("failed", failed, 0)
+				t2 = f.AddTask("slow", sleep, 0)
+			)
+			f.AddTask("dependent", record("dependent", nil), 0, t1)
+			f.AddTask("independent", record("independent", nil), 0, t2)
+		})
+
+		It("should not start any further task with the fail-fast policy", func() {
+			Expect(f.SetErrorPolicy(ErrorPolicyFailFast).Execute(context.TODO())).NotTo(BeNil())
+			Expect(executed).To(Equal([]string{"slow"}))
+		})
+
+		It("should only start independent tasks with the continue-independent policy", func() {
+			Expect(f.SetErrorPolicy(ErrorPolicyContinueIndependent).Execute(context.TODO())).NotTo(BeNil())
+			Expect(executed).To(Equal([]string{"slow", "independent"}))
+		})
+
+		It("should start dependents of failed tasks with the best-effort policy", func() {
+			Expect(f.SetErrorPolicy(ErrorPolicyBestEffort).Execute(context.TODO())).NotTo(BeNil())
+			Expect(executed).To(Equal([]string{"dependent", "slow", "independent"}))
+		})
+	})
+
+	Describe("#Resume", func() {
+		It("should record succeeded resumable tasks", func() {
+			var (
+				recordedFlow  string
+				recordedTasks []string
+
+				f = New("test").SetLogger(logger).SetStateRecorder(func(f *Flow) {
+					recordedFlow = f.Name
+					recordedTasks = append([]string{}, f.SucceededTasks...)
+				})
+				t1 = f.AddTask("first", record("first", nil), 0).SetResumable()
+				_  = f.AddTask("second", record("second", nil), 0, t1)
+			)
+
+			Expect(f.Execute(context.TODO())).To(BeNil())
+			Expect(recordedFlow).To(Equal("test"))
+			Expect(recordedTasks).To(Equal([]string{"first"}))
+		})
+
+		It("should skip resumable tasks which have already succeeded", func() {
+			var (
+				f         = New("test").SetLogger(logger)
+				function1 = record("first", nil)
+				function2 = record("second", nil)
+				t1        = f.AddTask("first", function1, 0).SetResumable()
+				_         = f.AddTask("second", function2, 0, t1)
+			)
+			f.Resume([]string{"first", "second"})
+
+			Expect(f.Execute(context.TODO())).To(BeNil())
+			Expect(executed).To(Equal([]string{"second"}))
+		})
+	})
+
+	Describe("#Graph", func() {
+		It("should return the status, the dependencies and the retry count of all tasks", func() {
+			var (
+				f  = New("test").SetLogger(logger)
+				t1 = f.AddTask("first", record("first", nil), 0)
+				_  = f.AddTaskConditional("second", record("second", nil), 0, false, t1)
+				_  = f.AddTask("third", record("third", errors.New("fail")), 0, t1)
+			)
+			f.Execute(context.TODO())
+
+			graph := f.Graph()
+
+			Expect(graph.Name).To(Equal("test"))
+			Expect(graph.Tasks).To(HaveLen(3))
+			Expect(graph.Tasks[0].Status).To(Equal(TaskStatusSucceeded))
+			Expect(graph.Tasks[0].StartTime).NotTo(BeNil())
+			Expect(graph.Tasks[0].EndTime).NotTo(BeNil())
+			Expect(graph.Tasks[1].Status).To(Equal(TaskStatusSkipped))
+			Expect(graph.Tasks[1].DependsOn).To(Equal([]string{"first"}))
+			Expect(graph.Tasks[2].Status).To(Equal(TaskStatusFailed))
+			Expect(graph.Tasks[2].Error).To(Equal("fail"))
+			Expect(graph.Tasks[2].RetryCount).To(Equal(0))
+		})
+	})
+
+	Describe("#ExportDOT", func() {
+		It("should return the graph in the DOT language", func() {
+			var (
+				f  = New("test").SetLogger(logger)
+				t1 = f.AddTask("first", record("first", nil), 0)
+				_  = f.AddTask("second", record("second", nil), 0, t1)
+			)
+
+			Expect(f.ExportDOT()).To(Equal(`digraph "test" {
+  "first" [label="first\nPending", color="black"];
+  "second" [label="second\nPending", color="black"];
+  "first" -> "second";
+}
+`))
+		})
+	})
+})
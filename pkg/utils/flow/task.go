@@ -14,9 +14,26 @@
 
 package flow
 
-import "github.com/gardener/gardener/pkg/utils"
+import "time"
 
 // String will returns the string representation of the task.
 func (t *Task) String() string {
-	return utils.FuncName(t.Function)
+	return t.Name
+}
+
+// SetResumable marks the task as resumable. The successful execution of a resumable task is recorded by the
+// state recorder of the flow, and the task will not be executed again if the flow is resumed with a state
+// which already contains it. Only tasks which do not compute in-memory state required by other tasks must be
+// marked as resumable.
+func (t *Task) SetResumable() *Task {
+	t.Resumable = true
+	return t
+}
+
+// SetTimeout sets a hard <timeout> for the task. Contrary to the retry duration, which only limits the time in
+// which failed executions are retried, the context of the task is done once the timeout is exceeded. The flow
+// still waits for the function to return, hence, it should respect its context.
+func (t *Task) SetTimeout(timeout time.Duration) *Task {
+	t.Timeout = timeout
+	return t
 }
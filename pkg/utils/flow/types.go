@@ -15,6 +15,8 @@
 package flow
 
 import (
+	"context"
+	"sync"
 	"time"
 
 	utilerrors "github.com/gardener/gardener/pkg/operation/errors"
@@ -28,21 +30,66 @@ type TaskList []*Task
 type Flow struct {
 	Name                    string
 	Logger                  *logrus.Entry
+	ErrorPolicy             ErrorPolicy
 	ProgressReporterFunc    func(int, string)
+	StateRecorderFunc       func(*Flow)
 	DoneCh                  chan *Task
+	Tasks                   TaskList
 	RootTasks               TaskList
 	ActiveTasks             TaskList
 	ErrornousTasks          TaskList
+	SucceededTasks          []string
+	ResumedTasks            []string
 	NumberOfExecutableTasks int
 	NumberOfCompletedTasks  int
+
+	lock sync.Mutex
 }
 
+// ErrorPolicy defines how the flow proceeds when a task returns an error.
+type ErrorPolicy string
+
+const (
+	// ErrorPolicyFailFast does not start any further task once a task has failed. Tasks which are already running
+	// are executed until they complete.
+	ErrorPolicyFailFast ErrorPolicy = "FailFast"
+	// ErrorPolicyContinueIndependent does not start the tasks which (transitively) depend on a failed task, but it
+	// continues executing all independent tasks until the graph is drained. This is the default policy.
+	ErrorPolicyContinueIndependent ErrorPolicy = "ContinueIndependent"
+	// ErrorPolicyBestEffort executes all tasks, i.e. the tasks depending on a failed task are started as well.
+	ErrorPolicyBestEffort ErrorPolicy = "BestEffort"
+)
+
 // Task is the definition of a task in the flow.
 type Task struct {
-	Function                    func() error
+	Name                        string
+	Function                    func(context.Context) error
 	RetryDuration               time.Duration
+	Timeout                     time.Duration
 	Error                       *utilerrors.Error
 	Skip                        bool
+	Resumable                   bool
+	Status                      TaskStatus
+	StartTime                   *time.Time
+	EndTime                     *time.Time
+	Attempts                    int
+	Dependencies                TaskList
 	TriggerTasks                TaskList
 	NumberOfPendingDependencies int
 }
+
+// TaskStatus is the status of a task in the flow.
+type TaskStatus string
+
+const (
+	// TaskStatusPending indicates that the task is waiting for its dependencies.
+	TaskStatusPending TaskStatus = "Pending"
+	// TaskStatusRunning indicates that the task is currently executed.
+	TaskStatusRunning TaskStatus = "Running"
+	// TaskStatusSucceeded indicates that the task has been executed successfully.
+	TaskStatusSucceeded TaskStatus = "Succeeded"
+	// TaskStatusFailed indicates that the execution of the task has failed.
+	TaskStatusFailed TaskStatus = "Failed"
+	// TaskStatusSkipped indicates that the task has not been executed because its condition was false.
+	TaskStatusSkipped TaskStatus = "Skipped"
+)
@@ -0,0 +1,152 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/prometheus/prometheus/promql"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var (
+	prometheusMetricNameRegex = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	prometheusLabelNameRegex  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	prometheusDurationRegex   = regexp.MustCompile(`^[0-9]+(ms|s|m|h|d|w|y)$`)
+)
+
+type prometheusRuleGroups struct {
+	Groups []prometheusRuleGroup `yaml:"groups"`
+}
+
+type prometheusRuleGroup struct {
+	Name     string           `yaml:"name"`
+	Interval string           `yaml:"interval,omitempty"`
+	Rules    []prometheusRule `yaml:"rules"`
+}
+
+type prometheusRule struct {
+	Record      string            `yaml:"record,omitempty"`
+	Alert       string            `yaml:"alert,omitempty"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// ValidatePrometheusRules checks whether the given <data> is a valid Prometheus (2.x) rule file. It verifies the
+// structure of the rule groups, the names of the recording rules and labels, the durations, and the syntax of the
+// PromQL expressions.
+func ValidatePrometheusRules(data string) error {
+	ruleGroups := &prometheusRuleGroups{}
+	if err := yaml.UnmarshalStrict([]byte(data), ruleGroups); err != nil {
+		return err
+	}
+
+	if len(ruleGroups.Groups) == 0 {
+		return fmt.Errorf("no rule groups found")
+	}
+
+	groupNames := map[string]bool{}
+	for _, group := range ruleGroups.Groups {
+		if len(group.Name) == 0 {
+			return fmt.Errorf("rule group name must not be empty")
+		}
+		if groupNames[group.Name] {
+			return fmt.Errorf("rule group %q is defined more than once", group.Name)
+		}
+		groupNames[group.Name] = true
+
+		if len(group.Interval) > 0 && !prometheusDurationRegex.MatchString(group.Interval) {
+			return fmt.Errorf("rule group %q: invalid interval %q", group.Name, group.Interval)
+		}
+
+		for i, rule := range group.Rules {
+			if err := validatePrometheusRule(rule); err != nil {
+				return fmt.Errorf("rule group %q, rule %d: %v", group.Name, i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddPrometheusRuleLabels adds the given <labels> to all alerting rules of the Prometheus rule file <data> and
+// returns the resulting rule file. Existing labels with the same names are overwritten.
+func AddPrometheusRuleLabels(data string, labels map[string]string) (string, error) {
+	ruleGroups := &prometheusRuleGroups{}
+	if err := yaml.UnmarshalStrict([]byte(data), ruleGroups); err != nil {
+		return "", err
+	}
+
+	for i, group := range ruleGroups.Groups {
+		for j, rule := range group.Rules {
+			if len(rule.Alert) == 0 {
+				continue
+			}
+			if rule.Labels == nil {
+				rule.Labels = map[string]string{}
+			}
+			for name, value := range labels {
+				rule.Labels[name] = value
+			}
+			ruleGroups.Groups[i].Rules[j] = rule
+		}
+	}
+
+	out, err := yaml.Marshal(ruleGroups)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func validatePrometheusRule(rule prometheusRule) error {
+	switch {
+	case len(rule.Record) > 0 && len(rule.Alert) > 0:
+		return fmt.Errorf("only one of 'record' and 'alert' must be set")
+	case len(rule.Record) == 0 && len(rule.Alert) == 0:
+		return fmt.Errorf("one of 'record' or 'alert' must be set")
+	case len(rule.Record) > 0:
+		if !prometheusMetricNameRegex.MatchString(rule.Record) {
+			return fmt.Errorf("invalid recording rule name %q", rule.Record)
+		}
+		if len(rule.For) > 0 || len(rule.Annotations) > 0 {
+			return fmt.Errorf("recording rule %q must not set 'for' or 'annotations'", rule.Record)
+		}
+	}
+
+	if len(rule.For) > 0 && !prometheusDurationRegex.MatchString(rule.For) {
+		return fmt.Errorf("invalid duration %q", rule.For)
+	}
+
+	for name := range rule.Labels {
+		if !prometheusLabelNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid label name %q", name)
+		}
+	}
+	for name := range rule.Annotations {
+		if !prometheusLabelNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid annotation name %q", name)
+		}
+	}
+
+	if _, err := promql.ParseExpr(rule.Expr); err != nil {
+		return fmt.Errorf("invalid expression %q: %v", rule.Expr, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	. "github.com/gardener/gardener/pkg/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("prometheus", func() {
+	Describe("#ValidatePrometheusRules", func() {
+		It("should accept valid recording and alerting rules", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  interval: 1m
+  rules:
+  - record: job:http_requests:rate5m
+    expr: sum(rate(http_requests_total{job="my-app"}[5m])) by (job)
+  - alert: HighErrorRate
+    expr: sum(rate(http_requests_total{code=~"5.."}[5m])) / sum(rate(http_requests_total[5m])) > 0.05
+    for: 10m
+    labels:
+      severity: critical
+    annotations:
+      description: More than 5% of the requests fail, current value is {{ $value }}.
+`)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject rule files without groups", func() {
+			err := ValidatePrometheusRules(`groups: []`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject unknown fields", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    expression: up == 0
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject duplicate group names", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    expr: up == 0
+- name: team-a.rules
+  rules:
+  - alert: Bar
+    expr: up == 0
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject rules which are both recording and alerting rules", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    record: foo
+    expr: up == 0
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject invalid recording rule names", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - record: foo-bar
+    expr: up
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject invalid durations", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    expr: up == 0
+    for: 5 minutes
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject empty expressions", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    expr: ""
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject expressions with unbalanced brackets", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    expr: sum(rate(http_requests_total{job="my-app"}[5m]) > 0
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject expressions with unterminated string literals", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    expr: 'up{job="my-app} == 0'
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject expressions with syntax errors", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    expr: sum(up) by job > 0
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject expressions calling unknown functions", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    expr: increase_rate(http_requests_total[5m]) > 0
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject expressions with invalid regular expressions", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    expr: 'up{job=~"my-app-(a|b"} == 0'
+`)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should accept brackets within string literals", func() {
+			err := ValidatePrometheusRules(`groups:
+- name: team-a.rules
+  rules:
+  - alert: Foo
+    expr: 'up{job="my-app-(a"} == 0'
+`)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#AddPrometheusRuleLabels", func() {
+		It("should add the labels to the alerting rules only", func() {
+			data, err := AddPrometheusRuleLabels(`groups:
+- name: team-a.rules
+  rules:
+  - record: job:up:sum
+    expr: sum(up) by (job)
+  - alert: Foo
+    expr: up == 0
+    labels:
+      severity: critical
+      origin: gardener
+`, map[string]string{"origin": "shoot"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ValidatePrometheusRules(data)).To(Succeed())
+			Expect(data).To(Equal(`groups:
+- name: team-a.rules
+  rules:
+  - record: job:up:sum
+    expr: sum(up) by (job)
+  - alert: Foo
+    expr: up == 0
+    labels:
+      origin: shoot
+      severity: critical
+`))
+		})
+	})
+})
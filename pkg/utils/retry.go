@@ -15,16 +15,24 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // Retry tries a condition function <f> until it returns true or the timeout <maxWaitTime> is reached.
 // Retry always waits the 5 seconds before retrying <f> the next time.
 // It ensures that the function <f> is always executed at least once.
 func Retry(logger *logrus.Entry, maxWaitTime time.Duration, f func() (bool, error)) error {
+	return RetryWithContext(context.Background(), logger, maxWaitTime, f)
+}
+
+// RetryWithContext behaves like Retry, but it stops retrying the condition function <f> as soon as the
+// given context <ctx> is done. In that case, the error of the context is returned.
+func RetryWithContext(ctx context.Context, logger *logrus.Entry, maxWaitTime time.Duration, f func() (bool, error)) error {
 	var startTime = time.Now().UTC()
 
 	for {
@@ -41,7 +49,33 @@ func Retry(logger *logrus.Entry, maxWaitTime time.Duration, f func() (bool, erro
 			return fmt.Errorf("Maximum waiting time exceeded after %s waiting time, but no error occurred", maxWaitTime)
 		}
 
-		time.Sleep(5 * time.Second)
+		select {
+		case <-ctx.Done():
+			logger.Errorf("Stopped retrying as the context is done (%s)", ctx.Err().Error())
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+// PollImmediateWithContext executes the <condition> function immediately and then every <interval> until it
+// returns true or an error, the <timeout> is reached or the given context <ctx> is done. It returns
+// wait.ErrWaitTimeout if the <timeout> is reached, and the error of the context if the context is done.
+func PollImmediateWithContext(ctx context.Context, interval, timeout time.Duration, condition wait.ConditionFunc) error {
+	timeoutCh := time.After(timeout)
+
+	for {
+		if ok, err := condition(); err != nil || ok {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeoutCh:
+			return wait.ErrWaitTimeout
+		case <-time.After(interval):
+		}
 	}
 }
 
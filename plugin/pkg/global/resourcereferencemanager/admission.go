@@ -24,8 +24,12 @@ import (
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	kubeinformers "k8s.io/client-go/informers"
@@ -49,6 +53,7 @@ type ReferenceManager struct {
 	*admission.Handler
 	authorizer          authorizer.Authorizer
 	secretLister        kubecorev1listers.SecretLister
+	configMapLister     kubecorev1listers.ConfigMapLister
 	cloudProfileLister  gardenlisters.CloudProfileLister
 	seedLister          gardenlisters.SeedLister
 	secretBindingLister gardenlisters.SecretBindingLister
@@ -82,6 +87,7 @@ func (r *ReferenceManager) SetInternalGardenInformerFactory(f gardeninformers.Sh
 // SetKubeInformerFactory gets Lister from SharedInformerFactory.
 func (r *ReferenceManager) SetKubeInformerFactory(f kubeinformers.SharedInformerFactory) {
 	r.secretLister = f.Core().V1().Secrets().Lister()
+	r.configMapLister = f.Core().V1().ConfigMaps().Lister()
 }
 
 // ValidateInitialization checks whether the plugin was correctly initialized.
@@ -92,6 +98,9 @@ func (r *ReferenceManager) ValidateInitialization() error {
 	if r.secretLister == nil {
 		return errors.New("missing secret lister")
 	}
+	if r.configMapLister == nil {
+		return errors.New("missing config map lister")
+	}
 	if r.cloudProfileLister == nil {
 		return errors.New("missing cloud profile lister")
 	}
@@ -152,7 +161,14 @@ func (r *ReferenceManager) Admit(a admission.Attributes) error {
 		if skipVerification(operation, shoot.ObjectMeta) {
 			return nil
 		}
-		err = r.ensureShootReferences(shoot)
+		var oldShoot *garden.Shoot
+		if operation == admission.Update {
+			oldShoot, ok = a.GetOldObject().(*garden.Shoot)
+			if !ok {
+				return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+			}
+		}
+		err = r.ensureShootReferences(shoot, oldShoot)
 	}
 
 	if err != nil {
@@ -233,7 +249,7 @@ func (r *ReferenceManager) ensureSeedReferences(seed *garden.Seed) error {
 	return nil
 }
 
-func (r *ReferenceManager) ensureShootReferences(shoot *garden.Shoot) error {
+func (r *ReferenceManager) ensureShootReferences(shoot, oldShoot *garden.Shoot) error {
 	if _, err := r.cloudProfileLister.Get(shoot.Spec.Cloud.Profile); err != nil {
 		return err
 	}
@@ -248,5 +264,141 @@ func (r *ReferenceManager) ensureShootReferences(shoot *garden.Shoot) error {
 		return err
 	}
 
+	if kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.AuditConfig != nil {
+		if err := r.ensureAuditConfigReferences(shoot.Namespace, kubeAPIServer.AuditConfig); err != nil {
+			return err
+		}
+	}
+
+	if monitoring := shoot.Spec.Monitoring; monitoring != nil {
+		if monitoring.Alerting != nil {
+			var oldReceivers []garden.AlertReceiver
+			if oldShoot != nil && oldShoot.Spec.Monitoring != nil && oldShoot.Spec.Monitoring.Alerting != nil {
+				oldReceivers = oldShoot.Spec.Monitoring.Alerting.Receivers
+			}
+			if err := r.ensureAlertReceiverReferences(shoot.Namespace, monitoring.Alerting.Receivers, oldReceivers); err != nil {
+				return err
+			}
+		}
+		if monitoring.Rules != nil {
+			if err := r.ensureMonitoringRulesReferences(shoot.Namespace, monitoring.Rules); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *ReferenceManager) ensureMonitoringRulesReferences(namespace string, rules *garden.MonitoringRules) error {
+	configMap, err := r.configMapLister.ConfigMaps(namespace).Get(rules.ConfigMapRef.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(configMap.Data) == 0 {
+		return fmt.Errorf("config map %s does not contain any Prometheus rule files", configMap.Name)
+	}
+	for key, data := range configMap.Data {
+		if err := utils.ValidatePrometheusRules(data); err != nil {
+			return fmt.Errorf("config map %s does not contain a valid Prometheus rule file in its %q key: %v", configMap.Name, key, err)
+		}
+	}
+
+	return nil
+}
+
+// ensureAlertReceiverReferences ensures that the secrets referenced by the given alert <receivers> exist. Alerts are
+// sent to email receivers via the alerting SMTP secret of the Garden, hence, it must exist if email receivers are
+// added. Email receivers which are already contained in <oldReceivers> are not checked again, otherwise updates of
+// existing Shoots would be rejected if the SMTP secret has been removed in the meantime.
+func (r *ReferenceManager) ensureAlertReceiverReferences(namespace string, receivers, oldReceivers []garden.AlertReceiver) error {
+	oldEmailReceivers := sets.NewString()
+	for _, receiver := range oldReceivers {
+		if receiver.Email != nil {
+			oldEmailReceivers.Insert(receiver.Name)
+		}
+	}
+
+	for _, receiver := range receivers {
+		var (
+			secretName string
+			dataKey    string
+		)
+
+		switch {
+		case receiver.Email != nil:
+			if oldEmailReceivers.Has(receiver.Name) {
+				continue
+			}
+			if err := r.ensureAlertingSMTPSecret(); err != nil {
+				return fmt.Errorf("email alert receiver %s cannot be used: %v", receiver.Name, err)
+			}
+			continue
+		case receiver.Slack != nil:
+			secretName, dataKey = receiver.Slack.SecretRef.Name, garden.AlertReceiverSecretURLKey
+		case receiver.PagerDuty != nil:
+			secretName, dataKey = receiver.PagerDuty.SecretRef.Name, garden.AlertReceiverSecretRoutingKeyKey
+		case receiver.Webhook != nil:
+			secretName, dataKey = receiver.Webhook.SecretRef.Name, garden.AlertReceiverSecretURLKey
+		default:
+			continue
+		}
+
+		secret, err := r.secretLister.Secrets(namespace).Get(secretName)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := secret.Data[dataKey]; !ok {
+			return fmt.Errorf("secret %s referenced by alert receiver %s does not contain the %q key", secret.Name, receiver.Name, dataKey)
+		}
+	}
+
+	return nil
+}
+
+func (r *ReferenceManager) ensureAlertingSMTPSecret() error {
+	selector, err := labels.Parse(fmt.Sprintf("%s=%s", common.GardenRole, common.GardenRoleAlertingSMTP))
+	if err != nil {
+		return err
+	}
+	secrets, err := r.secretLister.Secrets(common.GardenNamespace).List(selector)
+	if err != nil {
+		return err
+	}
+	if len(secrets) == 0 {
+		return errors.New("no alerting SMTP secret is configured in the Garden cluster")
+	}
+	return nil
+}
+
+func (r *ReferenceManager) ensureAuditConfigReferences(namespace string, auditConfig *garden.AuditConfig) error {
+	if auditConfig.AuditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
+		configMap, err := r.configMapLister.ConfigMaps(namespace).Get(auditConfig.AuditPolicy.ConfigMapRef.Name)
+		if err != nil {
+			return err
+		}
+
+		policy, ok := configMap.Data[garden.AuditPolicyConfigMapDataKey]
+		if !ok {
+			return fmt.Errorf("config map %s does not contain an audit policy in its %q key", configMap.Name, garden.AuditPolicyConfigMapDataKey)
+		}
+		if err := validateAuditPolicy(policy); err != nil {
+			return fmt.Errorf("config map %s does not contain a valid audit policy: %v", configMap.Name, err)
+		}
+	}
+
+	if auditConfig.Webhook != nil {
+		secret, err := r.secretLister.Secrets(namespace).Get(auditConfig.Webhook.KubeconfigSecretRef.Name)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := secret.Data[garden.AuditWebhookKubeconfigSecretDataKey]; !ok {
+			return fmt.Errorf("secret %s does not contain a kubeconfig in its %q key", secret.Name, garden.AuditWebhookKubeconfigSecretDataKey)
+		}
+	}
+
 	return nil
 }
@@ -17,6 +17,7 @@ package resourcereferencemanager_test
 import (
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
 	. "github.com/gardener/gardener/plugin/pkg/global/resourcereferencemanager"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -347,6 +348,277 @@ var _ = Describe("resourcereferencemanager", func() {
 
 				Expect(err).To(HaveOccurred())
 			})
+
+			Context("audit configuration", func() {
+				var (
+					auditPolicyConfigMap = corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "audit-policy",
+							Namespace: namespace,
+						},
+						Data: map[string]string{
+							"policy": `apiVersion: audit.k8s.io/v1beta1
+kind: Policy
+rules:
+- level: Metadata`,
+						},
+					}
+					auditWebhookSecret = corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "audit-webhook",
+							Namespace: namespace,
+						},
+						Data: map[string][]byte{
+							"kubeconfig": []byte("kubeconfig"),
+						},
+					}
+				)
+
+				BeforeEach(func() {
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)
+
+					shoot.Spec.Kubernetes.KubeAPIServer = &garden.KubeAPIServerConfig{
+						AuditConfig: &garden.AuditConfig{
+							AuditPolicy: &garden.AuditPolicy{
+								ConfigMapRef: &corev1.LocalObjectReference{Name: auditPolicyConfigMap.Name},
+							},
+							Webhook: &garden.AuditWebhookBackend{
+								KubeconfigSecretRef: corev1.LocalObjectReference{Name: auditWebhookSecret.Name},
+							},
+						},
+					}
+				})
+
+				It("should accept because the referenced audit policy and webhook kubeconfig have been found", func() {
+					kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&auditPolicyConfigMap)
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&auditWebhookSecret)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should reject because the referenced audit policy config map does not exist", func() {
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&auditWebhookSecret)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).To(HaveOccurred())
+				})
+
+				It("should reject because the referenced config map does not contain a valid audit policy", func() {
+					configMap := auditPolicyConfigMap
+					configMap.Data = map[string]string{
+						"policy": `apiVersion: audit.k8s.io/v1beta1
+kind: Policy
+rules:
+- level: Everything`,
+					}
+					kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&configMap)
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&auditWebhookSecret)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).To(HaveOccurred())
+				})
+
+				It("should reject because the referenced webhook kubeconfig secret does not exist", func() {
+					kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&auditPolicyConfigMap)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).To(HaveOccurred())
+				})
+			})
+
+			Context("alert receivers", func() {
+				var (
+					slackSecret = corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "slack",
+							Namespace: namespace,
+						},
+						Data: map[string][]byte{
+							"url": []byte("https://hooks.slack.com/services/foo"),
+						},
+					}
+					alertingSMTPSecret = corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "alerting-smtp",
+							Namespace: common.GardenNamespace,
+							Labels:    map[string]string{common.GardenRole: common.GardenRoleAlertingSMTP},
+						},
+					}
+				)
+
+				BeforeEach(func() {
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)
+
+					shoot.Spec.Monitoring = &garden.Monitoring{
+						Alerting: &garden.Alerting{
+							Receivers: []garden.AlertReceiver{
+								{
+									Name:  "team-a",
+									Email: &garden.EmailReceiver{To: []string{"team-a@example.com"}},
+								},
+								{
+									Name:  "team-b",
+									Slack: &garden.SlackReceiver{SecretRef: corev1.LocalObjectReference{Name: slackSecret.Name}},
+								},
+							},
+						},
+					}
+				})
+
+				It("should accept because the referenced receiver secrets have been found", func() {
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&slackSecret)
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&alertingSMTPSecret)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should reject because the referenced receiver secret does not exist", func() {
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&alertingSMTPSecret)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).To(HaveOccurred())
+				})
+
+				It("should reject because the referenced receiver secret does not contain the url key", func() {
+					secret := slackSecret
+					secret.Data = map[string][]byte{
+						"webhook": []byte("https://hooks.slack.com/services/foo"),
+					}
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&secret)
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&alertingSMTPSecret)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).To(HaveOccurred())
+				})
+
+				It("should reject because no alerting SMTP secret is configured for the email receiver", func() {
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&slackSecret)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("no alerting SMTP secret is configured"))
+				})
+
+				It("should reject because an email receiver is added although no alerting SMTP secret is configured", func() {
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&slackSecret)
+					oldShoot := *shoot.DeepCopy()
+					oldShoot.Spec.Monitoring.Alerting.Receivers = oldShoot.Spec.Monitoring.Alerting.Receivers[1:]
+
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).To(HaveOccurred())
+				})
+
+				It("should accept the update of a Shoot whose email receiver already existed although no alerting SMTP secret is configured", func() {
+					kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&slackSecret)
+					oldShoot := *shoot.DeepCopy()
+
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("monitoring rules", func() {
+				var rulesConfigMap = corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "monitoring-rules",
+						Namespace: namespace,
+					},
+					Data: map[string]string{
+						"team-a.rules.yml": `groups:
+- name: team-a.rules
+  rules:
+  - alert: MyAppDown
+    expr: up{job="my-app"} == 0
+    for: 5m
+    labels:
+      severity: critical`,
+					},
+				}
+
+				BeforeEach(func() {
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)
+
+					shoot.Spec.Monitoring = &garden.Monitoring{
+						Rules: &garden.MonitoringRules{
+							ConfigMapRef: corev1.LocalObjectReference{Name: rulesConfigMap.Name},
+						},
+					}
+				})
+
+				It("should accept because the referenced config map contains valid rules", func() {
+					kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&rulesConfigMap)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should reject because the referenced config map does not exist", func() {
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).To(HaveOccurred())
+				})
+
+				It("should reject because the referenced config map contains invalid rules", func() {
+					configMap := rulesConfigMap
+					configMap.Data = map[string]string{
+						"team-a.rules.yml": `groups:
+- name: team-a.rules
+  rules:
+  - alert: MyAppDown
+    expr: sum(up{job="my-app"} == 0`,
+					}
+					kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(&configMap)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+					err := admissionHandler.Admit(attrs)
+
+					Expect(err).To(HaveOccurred())
+				})
+			})
 		})
 	})
 })
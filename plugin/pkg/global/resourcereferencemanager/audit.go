@@ -0,0 +1,49 @@
+// Copyright 2018 The Gardener Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcereferencemanager
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apiserver/pkg/apis/audit"
+	auditv1alpha1 "k8s.io/apiserver/pkg/apis/audit/v1alpha1"
+	auditv1beta1 "k8s.io/apiserver/pkg/apis/audit/v1beta1"
+	auditvalidation "k8s.io/apiserver/pkg/apis/audit/validation"
+)
+
+var auditCodecs = func() serializer.CodecFactory {
+	scheme := runtime.NewScheme()
+	audit.AddToScheme(scheme)
+	auditv1alpha1.AddToScheme(scheme)
+	auditv1beta1.AddToScheme(scheme)
+	return serializer.NewCodecFactory(scheme)
+}()
+
+// validateAuditPolicy decodes the given audit policy and checks whether it is valid.
+func validateAuditPolicy(data string) error {
+	obj, _, err := auditCodecs.UniversalDecoder().Decode([]byte(data), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	policy, ok := obj.(*audit.Policy)
+	if !ok {
+		return fmt.Errorf("expected an audit policy but got %T", obj)
+	}
+
+	return auditvalidation.ValidatePolicy(policy).ToAggregate()
+}
@@ -38,24 +38,6 @@ const (
 	PluginName = "ShootQuotaValidator"
 )
 
-var (
-	quotaMetricNames = [6]v1.ResourceName{
-		garden.QuotaMetricCPU,
-		garden.QuotaMetricGPU,
-		garden.QuotaMetricMemory,
-		garden.QuotaMetricStorageStandard,
-		garden.QuotaMetricStoragePremium,
-		garden.QuotaMetricLoadbalancer}
-)
-
-type quotaWorker struct {
-	garden.Worker
-	// VolumeType is the type of the root volumes.
-	VolumeType string
-	// VolumeSize is the size of the root volume.
-	VolumeSize resource.Quantity
-}
-
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
 	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
@@ -236,8 +218,16 @@ func (h *RejectShootIfQuotaExceeded) isQuotaExceeded(shoot garden.Shoot, quota g
 		return nil, err
 	}
 
+	// Preemptible machines are only accounted separately if the Quota constrains them explicitly,
+	// otherwise they are counted against the regular metrics.
+	for metric, preemptibleMetric := range helper.PreemptibleQuotaMetrics {
+		if _, ok := quota.Spec.Metrics[preemptibleMetric]; !ok {
+			requiredResources[metric] = helper.SumQuantities(requiredResources[metric], requiredResources[preemptibleMetric])
+		}
+	}
+
 	exceededMetrics := make([]v1.ResourceName, 0)
-	for _, metric := range quotaMetricNames {
+	for _, metric := range helper.QuotaMetricNames {
 		if _, ok := quota.Spec.Metrics[metric]; !ok {
 			continue
 		}
@@ -257,15 +247,37 @@ func (h *RejectShootIfQuotaExceeded) determineAllocatedResources(quota garden.Qu
 		return nil, err
 	}
 
-	// Collect the resources which are allocated according to the shoot specs
+	// The Quota controller maintains the resources allocated by all consuming Shoots in the status of the Quota.
+	// We only have to compute them on our own if the controller has not yet observed the Quota.
 	allocatedResources := make(v1.ResourceList)
+	if quota.Status.Used != nil {
+		allocatedResources = quota.Status.Used.DeepCopy()
+
+		// The allocated resources already contain the resources of the Shoot which is updated, hence, they must
+		// not be counted twice. The Shoot might have been changed since the controller has observed it the last
+		// time, so we subtract what has been booked for it instead of what its previous specification allocates.
+		if shootUsage := findShootUsage(quota.Status, shoot.Namespace, shoot.Name); shootUsage != nil {
+			for _, metric := range helper.QuotaMetricNames {
+				allocated := allocatedResources[metric]
+				allocated.Sub(shootUsage.Used[metric])
+				allocatedResources[metric] = allocated
+			}
+		}
+	}
+
+	// Collect the resources which are allocated according to the shoot specs. Shoots which are already
+	// contained in the status of the Quota are skipped, but those which have been created since the
+	// controller has observed the Quota the last time must be taken into account.
 	for _, s := range shoots {
+		if quota.Status.Used != nil && findShootUsage(quota.Status, s.Namespace, s.Name) != nil {
+			continue
+		}
 		shootResources, err := h.getShootResources(s)
 		if err != nil {
 			return nil, err
 		}
-		for _, metric := range quotaMetricNames {
-			allocatedResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
+		for _, metric := range helper.QuotaMetricNames {
+			allocatedResources[metric] = helper.SumQuantities(allocatedResources[metric], shootResources[metric])
 		}
 	}
 
@@ -310,6 +322,15 @@ func (h *RejectShootIfQuotaExceeded) findShootsReferQuota(quota garden.Quota, sh
 	return shootsReferQuota, nil
 }
 
+func findShootUsage(quotaStatus garden.QuotaStatus, namespace, name string) *garden.QuotaShootUsage {
+	for i, shootUsage := range quotaStatus.Shoots {
+		if shootUsage.Namespace == namespace && shootUsage.Name == name {
+			return &quotaStatus.Shoots[i]
+		}
+	}
+	return nil
+}
+
 func (h *RejectShootIfQuotaExceeded) determineRequiredResources(allocatedResources v1.ResourceList, shoot garden.Shoot) (v1.ResourceList, error) {
 	shootResources, err := h.getShootResources(shoot)
 	if err != nil {
@@ -317,8 +338,8 @@ func (h *RejectShootIfQuotaExceeded) determineRequiredResources(allocatedResourc
 	}
 
 	requiredResourches := make(v1.ResourceList)
-	for _, metric := range quotaMetricNames {
-		requiredResourches[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
+	for _, metric := range helper.QuotaMetricNames {
+		requiredResourches[metric] = helper.SumQuantities(allocatedResources[metric], shootResources[metric])
 	}
 	return requiredResourches, nil
 }
@@ -329,162 +350,7 @@ func (h *RejectShootIfQuotaExceeded) getShootResources(shoot garden.Shoot) (v1.R
 		return nil, apierrors.NewBadRequest("could not find referenced cloud profile")
 	}
 
-	cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
-	if err != nil {
-		return nil, apierrors.NewBadRequest("could not identify the cloud provider kind in the Shoot resource")
-	}
-
-	var (
-		countLB      int64 = 1
-		resources          = make(v1.ResourceList)
-		workers            = getShootWorkerResources(shoot, cloudProvider, *cloudProfile)
-		machineTypes       = getMachineTypes(cloudProvider, *cloudProfile)
-		volumeTypes        = getVolumeTypes(cloudProvider, *cloudProfile)
-	)
-
-	for _, worker := range workers {
-		var (
-			machineType *garden.MachineType
-			volumeType  *garden.VolumeType
-		)
-
-		// Get the proper machineType
-		for _, element := range machineTypes {
-			if element.Name == worker.MachineType {
-				machineType = &element
-				break
-			}
-		}
-		if machineType == nil {
-			return nil, fmt.Errorf("MachineType %s not found in CloudProfile %s", worker.MachineType, cloudProfile.Name)
-		}
-
-		// Get the proper VolumeType
-		for _, element := range volumeTypes {
-			if element.Name == worker.VolumeType {
-				volumeType = &element
-				break
-			}
-		}
-		if volumeType == nil {
-			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile %s", worker.MachineType, cloudProfile.Name)
-		}
-
-		// For now we always use the max. amount of resources for quota calculation
-		resources[garden.QuotaMetricCPU] = multiplyQuantity(machineType.CPU, worker.AutoScalerMax)
-		resources[garden.QuotaMetricGPU] = multiplyQuantity(machineType.GPU, worker.AutoScalerMax)
-		resources[garden.QuotaMetricMemory] = multiplyQuantity(machineType.Memory, worker.AutoScalerMax)
-
-		switch volumeType.Class {
-		case garden.VolumeClassStandard:
-			resources[garden.QuotaMetricStorageStandard] = multiplyQuantity(worker.VolumeSize, worker.AutoScalerMax)
-		case garden.VolumeClassPremium:
-			resources[garden.QuotaMetricStoragePremium] = multiplyQuantity(worker.VolumeSize, worker.AutoScalerMax)
-		default:
-			return nil, fmt.Errorf("Unknown volumeType class %s", volumeType.Class)
-		}
-	}
-
-	if shoot.Spec.Addons != nil && shoot.Spec.Addons.NginxIngress != nil && shoot.Spec.Addons.NginxIngress.Addon.Enabled {
-		countLB++
-	}
-	resources[garden.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
-
-	return resources, nil
-}
-
-func getShootWorkerResources(shoot garden.Shoot, cloudProvider garden.CloudProvider, cloudProfile garden.CloudProfile) []quotaWorker {
-	var workers []quotaWorker
-
-	switch cloudProvider {
-	case garden.CloudProviderAWS:
-		workers = make([]quotaWorker, len(shoot.Spec.Cloud.AWS.Workers))
-
-		for idx, awsWorker := range shoot.Spec.Cloud.AWS.Workers {
-			workers[idx].Worker = awsWorker.Worker
-			workers[idx].VolumeType = awsWorker.VolumeType
-			workers[idx].VolumeSize = resource.MustParse(awsWorker.VolumeSize)
-		}
-	case garden.CloudProviderAzure:
-		workers = make([]quotaWorker, len(shoot.Spec.Cloud.Azure.Workers))
-
-		for idx, azureWorker := range shoot.Spec.Cloud.Azure.Workers {
-			workers[idx].Worker = azureWorker.Worker
-			workers[idx].VolumeType = azureWorker.VolumeType
-			workers[idx].VolumeSize = resource.MustParse(azureWorker.VolumeSize)
-		}
-	case garden.CloudProviderGCP:
-		workers = make([]quotaWorker, len(shoot.Spec.Cloud.GCP.Workers))
-
-		for idx, gcpWorker := range shoot.Spec.Cloud.GCP.Workers {
-			workers[idx].Worker = gcpWorker.Worker
-			workers[idx].VolumeType = gcpWorker.VolumeType
-			workers[idx].VolumeSize = resource.MustParse(gcpWorker.VolumeSize)
-		}
-	case garden.CloudProviderOpenStack:
-		workers = make([]quotaWorker, len(shoot.Spec.Cloud.OpenStack.Workers))
-
-		for idx, osWorker := range shoot.Spec.Cloud.OpenStack.Workers {
-			workers[idx].Worker = osWorker.Worker
-			for _, machineType := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
-				if osWorker.MachineType == machineType.Name {
-					workers[idx].VolumeType = machineType.MachineType.Name
-					workers[idx].VolumeSize = machineType.VolumeSize
-				}
-			}
-		}
-	}
-	return workers
-}
-
-func getMachineTypes(provider garden.CloudProvider, cloudProfile garden.CloudProfile) []garden.MachineType {
-	var machineTypes []garden.MachineType
-	switch provider {
-	case garden.CloudProviderAWS:
-		machineTypes = cloudProfile.Spec.AWS.Constraints.MachineTypes
-	case garden.CloudProviderAzure:
-		machineTypes = cloudProfile.Spec.Azure.Constraints.MachineTypes
-	case garden.CloudProviderGCP:
-		machineTypes = cloudProfile.Spec.GCP.Constraints.MachineTypes
-	case garden.CloudProviderOpenStack:
-		machineTypes = make([]garden.MachineType, 0)
-		for _, element := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
-			machineTypes = append(machineTypes, element.MachineType)
-		}
-	}
-	return machineTypes
-}
-
-func getVolumeTypes(provider garden.CloudProvider, cloudProfile garden.CloudProfile) []garden.VolumeType {
-	var volumeTypes []garden.VolumeType
-	switch provider {
-	case garden.CloudProviderAWS:
-		volumeTypes = cloudProfile.Spec.AWS.Constraints.VolumeTypes
-	case garden.CloudProviderAzure:
-		volumeTypes = cloudProfile.Spec.Azure.Constraints.VolumeTypes
-	case garden.CloudProviderGCP:
-		volumeTypes = cloudProfile.Spec.GCP.Constraints.VolumeTypes
-	case garden.CloudProviderOpenStack:
-		volumeTypes = make([]garden.VolumeType, 0)
-		contains := func(types []garden.VolumeType, volumeType string) bool {
-			for _, element := range types {
-				if element.Name == volumeType {
-					return true
-				}
-			}
-			return false
-		}
-
-		for _, machineType := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
-			if !contains(volumeTypes, machineType.MachineType.Name) {
-				volumeTypes = append(volumeTypes, garden.VolumeType{
-					Name:  machineType.MachineType.Name,
-					Class: machineType.VolumeType,
-				})
-			}
-		}
-	}
-	return volumeTypes
+	return helper.ComputeShootResources(shoot, *cloudProfile)
 }
 
 func lifetimeVerificationNeeded(new, old garden.Shoot) bool {
@@ -555,7 +421,7 @@ func quotaVerificationNeeded(new, old garden.Shoot, provider garden.CloudProvide
 			for _, oldWorker := range old.Spec.Cloud.GCP.Workers {
 				if worker.Name == oldWorker.Name {
 					oldHasWorker = true
-					if hasWorkerDiff(worker.Worker, oldWorker.Worker) || worker.VolumeType != oldWorker.VolumeType || worker.VolumeSize != oldWorker.VolumeSize {
+					if hasWorkerDiff(worker.Worker, oldWorker.Worker) || worker.VolumeType != oldWorker.VolumeType || worker.VolumeSize != oldWorker.VolumeSize || hasPreemptibleDiff(worker.Preemptible, oldWorker.Preemptible) {
 						return true
 					}
 				}
@@ -591,6 +457,10 @@ func hasWorkerDiff(new, old garden.Worker) bool {
 	return false
 }
 
+func hasPreemptibleDiff(new, old *bool) bool {
+	return (new != nil && *new) != (old != nil && *old)
+}
+
 func hasSufficientQuota(limit, required resource.Quantity) bool {
 	compareCode := limit.Cmp(required)
 	if compareCode == -1 {
@@ -598,19 +468,3 @@ func hasSufficientQuota(limit, required resource.Quantity) bool {
 	}
 	return true
 }
-
-func sumQuantity(values ...resource.Quantity) resource.Quantity {
-	res := resource.Quantity{}
-	for _, v := range values {
-		res.Add(v)
-	}
-	return res
-}
-
-func multiplyQuantity(quantity resource.Quantity, multiplier int) resource.Quantity {
-	res := resource.Quantity{}
-	for i := 0; i < multiplier; i++ {
-		res.Add(quantity)
-	}
-	return res
-}
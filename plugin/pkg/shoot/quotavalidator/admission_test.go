@@ -237,6 +237,152 @@ var _ = Describe("quotavalidator", func() {
 				err := admissionHandler.Admit(attrs)
 				Expect(err).NotTo(HaveOccurred())
 			})
+
+			It("should pass because preemptible workers do not consume the regular quota limits if the preemptible ones are constrained", func() {
+				preemptible := true
+				quotaProject.Spec.Metrics[garden.QuotaMetricCPU] = resource.MustParse("1")
+				quotaProject.Spec.Metrics[garden.QuotaMetricCPUPreemptible] = resource.MustParse("2")
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quotaProject)
+
+				shoot.Spec.Cloud.GCP.Workers[0].Preemptible = &preemptible
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should fail because preemptible workers exceed the regular quota limits if the preemptible ones are not constrained", func() {
+				preemptible := true
+				quotaProject.Spec.Metrics[garden.QuotaMetricCPU] = resource.MustParse("1")
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quotaProject)
+
+				shoot.Spec.Cloud.GCP.Workers[0].Preemptible = &preemptible
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail because preemptible workers exceed the preemptible quota limits", func() {
+				preemptible := true
+				quotaProject.Spec.Metrics[garden.QuotaMetricCPUPreemptible] = resource.MustParse("1")
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quotaProject)
+
+				shoot.Spec.Cloud.GCP.Workers[0].Preemptible = &preemptible
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("tests for Quotas whose usage has already been observed", func() {
+			var shootResources corev1.ResourceList
+
+			BeforeEach(func() {
+				shootResources = corev1.ResourceList{
+					garden.QuotaMetricCPU:             resource.MustParse("2"),
+					garden.QuotaMetricGPU:             resource.MustParse("0"),
+					garden.QuotaMetricMemory:          resource.MustParse("5Gi"),
+					garden.QuotaMetricStorageStandard: resource.MustParse("30Gi"),
+					garden.QuotaMetricStoragePremium:  resource.MustParse("0Gi"),
+					garden.QuotaMetricLoadbalancer:    resource.MustParse("2"),
+				}
+			})
+
+			It("should pass because the shoots listed in the status are not counted twice", func() {
+				shoot2 := *shoot.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&shoot2)
+
+				quotaProject.Status = garden.QuotaStatus{
+					Used: corev1.ResourceList{},
+					Shoots: []garden.QuotaShootUsage{
+						{
+							Namespace: shoot2.Namespace,
+							Name:      shoot2.Name,
+							Used:      corev1.ResourceList{},
+						},
+					},
+				}
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quotaProject)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should fail because shoots which are not yet listed in the status exhaust quota limits", func() {
+				quotaProject.Status.Used = corev1.ResourceList{}
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quotaProject)
+
+				shoot2 := *shoot.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&shoot2)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail because the used resources in the status exhaust quota limits", func() {
+				quotaProject.Status.Used = corev1.ResourceList{
+					garden.QuotaMetricCPU: resource.MustParse("1"),
+				}
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quotaProject)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should pass because the resources of the updated shoot are not counted twice", func() {
+				oldShoot = *shoot.DeepCopy()
+				quotaProject.Status = garden.QuotaStatus{
+					Used: shootResources,
+					Shoots: []garden.QuotaShootUsage{
+						{
+							Namespace: shoot.Namespace,
+							Name:      shoot.Name,
+							Used:      shootResources,
+						},
+					},
+				}
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quotaProject)
+
+				shoot.Spec.Cloud.GCP.Workers[0].Name = "test-worker-2"
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should fail because the booked resources of the updated shoot are subtracted instead of those of its previous specification", func() {
+				// The controller has not yet observed that the previous specification of the Shoot allocates more resources.
+				oldShoot = *shoot.DeepCopy()
+				oldShoot.Spec.Cloud.GCP.Workers[0].AutoScalerMax = 2
+				quotaProject.Status = garden.QuotaStatus{
+					Used: shootResources,
+					Shoots: []garden.QuotaShootUsage{
+						{
+							Namespace: shoot.Namespace,
+							Name:      shoot.Name,
+							Used:      shootResources,
+						},
+					},
+				}
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quotaProject)
+
+				shoot.Spec.Cloud.GCP.Workers[0].Name = "test-worker-2"
+				shoot.Spec.Cloud.GCP.Workers[0].AutoScalerMax = 2
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).To(HaveOccurred())
+			})
 		})
 
 		Context("tests for Quota validation corner cases", func() {
@@ -100,6 +100,23 @@ func (h *SeedManager) Admit(a admission.Attributes) error {
 			return admission.NewForbidden(a, errors.New("forbidden to use a protected seed"))
 		}
 
+		// If the Seed is changed then the control plane will be migrated to the new Seed. Hence, it must be
+		// suitable for the Shoot in the same way as if it had been determined automatically.
+		if a.GetOperation() == admission.Update {
+			oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+			if !ok {
+				return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+			}
+			if oldShoot.Spec.Cloud.Seed != nil && *oldShoot.Spec.Cloud.Seed != seed.Name {
+				if seed.Spec.Cloud.Profile != shoot.Spec.Cloud.Profile || seed.Spec.Cloud.Region != shoot.Spec.Cloud.Region {
+					return admission.NewForbidden(a, errors.New("forbidden to migrate to a seed with a different cloud profile or region"))
+				}
+				if !verifySeedAvailability(seed) {
+					return admission.NewForbidden(a, errors.New("forbidden to migrate to a seed which is not available"))
+				}
+			}
+		}
+
 		return nil
 	}
 
@@ -145,6 +145,57 @@ var _ = Describe("seedmanager", func() {
 			})
 		})
 
+		Context("Shoot references another Seed - migration", func() {
+			var (
+				oldShoot      garden.Shoot
+				otherSeedName = "seed-2"
+			)
+
+			BeforeEach(func() {
+				shoot.Spec.Cloud.Seed = &seedName
+				oldShoot = *shoot.DeepCopy()
+				oldShoot.Spec.Cloud.Seed = &otherSeedName
+			})
+
+			It("should pass because the new seed references the same profile and region and indicates availability", func() {
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should fail because the new seed references another region", func() {
+				seed.Spec.Cloud.Region = "another-region"
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should fail because the new seed is not available", func() {
+				seed.Status.Conditions = []garden.Condition{
+					{
+						Type:   garden.SeedAvailable,
+						Status: corev1.ConditionFalse,
+					},
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+
 		Context("Shoot does not reference a Seed - find an adequate one", func() {
 			BeforeEach(func() {
 				shoot.Spec.Cloud.Seed = nil
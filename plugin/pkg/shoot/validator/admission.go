@@ -280,6 +280,14 @@ func validateAWS(c *validationContext) field.ErrorList {
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.AWS.Constraints.VolumeTypes, worker.VolumeType, oldWorker.VolumeType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeType"), worker.VolumeType, validVolumeTypes))
 		}
+		if worker.MachineImage != nil {
+			if ok, validMachineImages := validateAWSMachineImagesConstraints(c.cloudProfile.Spec.AWS.Constraints.MachineImages, c.shoot.Spec.Cloud.Region, worker.MachineImage, oldWorker.MachineImage); !ok {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineImage"), *worker.MachineImage, validMachineImages))
+			}
+		}
+		if ok, validKubernetesVersions := validateKubeletVersionConstraints(c.cloudProfile.Spec.AWS.Constraints.Kubernetes.Versions, worker.KubeletVersion, oldWorker.KubeletVersion); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("kubeletVersion"), *worker.KubeletVersion, validKubernetesVersions))
+		}
 	}
 
 	for i, zone := range c.shoot.Spec.Cloud.AWS.Zones {
@@ -330,6 +338,14 @@ func validateAzure(c *validationContext) field.ErrorList {
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.Azure.Constraints.VolumeTypes, worker.VolumeType, oldWorker.VolumeType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeType"), worker.VolumeType, validVolumeTypes))
 		}
+		if worker.MachineImage != nil {
+			if ok, validMachineImages := validateAzureMachineImagesConstraints(c.cloudProfile.Spec.Azure.Constraints.MachineImages, worker.MachineImage, oldWorker.MachineImage); !ok {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineImage"), *worker.MachineImage, validMachineImages))
+			}
+		}
+		if ok, validKubernetesVersions := validateKubeletVersionConstraints(c.cloudProfile.Spec.Azure.Constraints.Kubernetes.Versions, worker.KubeletVersion, oldWorker.KubeletVersion); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("kubeletVersion"), *worker.KubeletVersion, validKubernetesVersions))
+		}
 	}
 
 	if ok := validateAzureDomainCount(c.cloudProfile.Spec.Azure.CountFaultDomains, c.shoot.Spec.Cloud.Region); !ok {
@@ -376,6 +392,17 @@ func validateGCP(c *validationContext) field.ErrorList {
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.GCP.Constraints.VolumeTypes, worker.VolumeType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeType"), worker.VolumeType, validVolumeTypes))
 		}
+		if worker.MachineImage != nil {
+			if ok, validMachineImages := validateGCPMachineImagesConstraints(c.cloudProfile.Spec.GCP.Constraints.MachineImages, worker.MachineImage, oldWorker.MachineImage); !ok {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineImage"), *worker.MachineImage, validMachineImages))
+			}
+		}
+		if ok, validKubernetesVersions := validateKubeletVersionConstraints(c.cloudProfile.Spec.GCP.Constraints.Kubernetes.Versions, worker.KubeletVersion, oldWorker.KubeletVersion); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("kubeletVersion"), *worker.KubeletVersion, validKubernetesVersions))
+		}
+		if ok, validMachineTypes := validatePreemptibleMachineTypes(c.cloudProfile.Spec.GCP.Constraints.PreemptibleMachineTypes, worker.Preemptible, oldWorker.Preemptible, worker.MachineType, oldWorker.MachineType); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineType"), worker.MachineType, validMachineTypes))
+		}
 	}
 
 	for i, zone := range c.shoot.Spec.Cloud.GCP.Zones {
@@ -429,6 +456,14 @@ func validateOpenStack(c *validationContext) field.ErrorList {
 		if ok, validMachineTypes := validateOpenStackMachineTypes(c.cloudProfile.Spec.OpenStack.Constraints.MachineTypes, worker.MachineType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineType"), worker.MachineType, validMachineTypes))
 		}
+		if worker.MachineImage != nil {
+			if ok, validMachineImages := validateOpenStackMachineImagesConstraints(c.cloudProfile.Spec.OpenStack.Constraints.MachineImages, worker.MachineImage, oldWorker.MachineImage); !ok {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineImage"), *worker.MachineImage, validMachineImages))
+			}
+		}
+		if ok, validKubernetesVersions := validateKubeletVersionConstraints(c.cloudProfile.Spec.OpenStack.Constraints.Kubernetes.Versions, worker.KubeletVersion, oldWorker.KubeletVersion); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("kubeletVersion"), *worker.KubeletVersion, validKubernetesVersions))
+		}
 	}
 
 	for i, zone := range c.shoot.Spec.Cloud.OpenStack.Zones {
@@ -487,6 +522,19 @@ func validateKubernetesVersionConstraints(constraints []string, version, oldVers
 	return false, validValues
 }
 
+func validateKubeletVersionConstraints(constraints []string, version, oldVersion *string) (bool, []string) {
+	if version == nil {
+		return true, nil
+	}
+
+	old := ""
+	if oldVersion != nil {
+		old = *oldVersion
+	}
+
+	return validateKubernetesVersionConstraints(constraints, *version, old)
+}
+
 func validateMachineTypes(constraints []garden.MachineType, machineType, oldMachineType string) (bool, []string) {
 	if machineType == oldMachineType {
 		return true, nil
@@ -504,6 +552,25 @@ func validateMachineTypes(constraints []garden.MachineType, machineType, oldMach
 	return false, validValues
 }
 
+// validatePreemptibleMachineTypes checks whether the machine type of a preemptible worker is allowed to be
+// used for preemptible instances. Workers which are not preemptible are always valid.
+func validatePreemptibleMachineTypes(constraints []string, preemptible, oldPreemptible *bool, machineType, oldMachineType string) (bool, []string) {
+	if preemptible == nil || !*preemptible {
+		return true, nil
+	}
+	if oldPreemptible != nil && *oldPreemptible && machineType == oldMachineType {
+		return true, nil
+	}
+
+	for _, t := range constraints {
+		if t == machineType {
+			return true, nil
+		}
+	}
+
+	return false, constraints
+}
+
 func validateOpenStackMachineTypes(constraints []garden.OpenStackMachineType, machineType, oldMachineType string) (bool, []string) {
 	machineTypes := []garden.MachineType{}
 	for _, t := range constraints {
@@ -630,7 +697,7 @@ func findAWSMachineImageForRegion(machineImageMapping garden.AWSMachineImageMapp
 }
 
 func validateAWSMachineImagesConstraints(constraints []garden.AWSMachineImageMapping, region string, image, oldImage *garden.AWSMachineImage) (bool, []string) {
-	if apiequality.Semantic.DeepEqual(*image, *oldImage) {
+	if oldImage != nil && apiequality.Semantic.DeepEqual(*image, *oldImage) {
 		return true, nil
 	}
 
@@ -661,7 +728,7 @@ func getAzureMachineImage(shoot *garden.Shoot, cloudProfile *garden.CloudProfile
 }
 
 func validateAzureMachineImagesConstraints(constraints []garden.AzureMachineImage, image, oldImage *garden.AzureMachineImage) (bool, []string) {
-	if apiequality.Semantic.DeepEqual(*image, *oldImage) {
+	if oldImage != nil && apiequality.Semantic.DeepEqual(*image, *oldImage) {
 		return true, nil
 	}
 
@@ -686,7 +753,7 @@ func getGCPMachineImage(shoot *garden.Shoot, cloudProfile *garden.CloudProfile)
 }
 
 func validateGCPMachineImagesConstraints(constraints []garden.GCPMachineImage, image, oldImage *garden.GCPMachineImage) (bool, []string) {
-	if apiequality.Semantic.DeepEqual(*image, *oldImage) {
+	if oldImage != nil && apiequality.Semantic.DeepEqual(*image, *oldImage) {
 		return true, nil
 	}
 
@@ -711,7 +778,7 @@ func getOpenStackMachineImage(shoot *garden.Shoot, cloudProfile *garden.CloudPro
 }
 
 func validateOpenStackMachineImagesConstraints(constraints []garden.OpenStackMachineImage, image, oldImage *garden.OpenStackMachineImage) (bool, []string) {
-	if apiequality.Semantic.DeepEqual(*image, *oldImage) {
+	if oldImage != nil && apiequality.Semantic.DeepEqual(*image, *oldImage) {
 		return true, nil
 	}
 